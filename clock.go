@@ -0,0 +1,219 @@
+package rfc3339
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time. It allows code that creates "now" values
+// to be given a deterministic time in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the [Clock] that reports the system time via [time.Now].
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock is a [Clock] that always reports the same time.
+type FixedClock time.Time
+
+// Now returns the fixed time.
+func (c FixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// Now returns the current time of the `clock` as a [DateTime]. A nil `clock`
+// results in [SystemClock] being used. The monotonic clock reading is
+// stripped, so that the result compares equal to the same instant parsed
+// from a string.
+func Now(clock Clock) DateTime {
+	if clock == nil {
+		clock = SystemClock
+	}
+	return NewFromTime(clock.Now().Round(0))
+}
+
+// Today returns the current day of the `clock`, in the location `loc`, as a
+// [FullDate]. A nil `clock` results in [SystemClock] being used. A nil `loc`
+// keeps the location of the time reported by the clock.
+func Today(clock Clock, loc *time.Location) FullDate {
+	if clock == nil {
+		clock = SystemClock
+	}
+	current := clock.Now()
+	if loc != nil {
+		current = current.In(loc)
+	}
+	return NewFullDate(current.Date())
+}
+
+// TodayIn returns the current day of the [SystemClock] in the location
+// `loc` as a [FullDate].
+func TodayIn(loc *time.Location) FullDate {
+	return Today(SystemClock, loc)
+}
+
+// FakeClock is a [Clock] whose time only changes when it is told to. Timers
+// created from it fire, in order of their deadlines, as the clock is moved
+// past them with [FakeClock.Advance] or [FakeClock.Set]. It is safe for
+// concurrent use.
+type FakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*FakeTimer
+}
+
+// NewFakeClock creates a [FakeClock] that reports `t` until it is moved.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by `d`, firing the timers that come due.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to `t`. When `t` is later than the current time, the
+// timers with deadlines up to and including `t` fire in order of their
+// deadlines, and in order of creation for equal deadlines. While a timer
+// fires, the clock reports the timer's deadline. Moving the clock backward
+// fires no timers.
+func (c *FakeClock) Set(t time.Time) {
+	for {
+		c.mu.Lock()
+		if len(c.timers) == 0 || c.timers[0].when.After(t) {
+			c.now = t
+			c.mu.Unlock()
+			return
+		}
+
+		timer := c.timers[0]
+		c.timers = c.timers[1:]
+		timer.active = false
+		c.now = timer.when
+		c.mu.Unlock()
+
+		timer.fire(timer.when)
+	}
+}
+
+// NewTimer creates a [FakeTimer] that sends the clock's time on its channel
+// once the clock has moved `d` past its current time. A timer whose duration
+// is not positive fires immediately.
+func (c *FakeClock) NewTimer(d time.Duration) *FakeTimer {
+	ch := make(chan time.Time, 1)
+	timer := &FakeTimer{C: ch, clock: c, ch: ch}
+	timer.Reset(d)
+	return timer
+}
+
+// After waits for the clock to move `d` past its current time and then
+// sends the clock's time on the returned channel.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C
+}
+
+// AfterFunc creates a [FakeTimer] that calls `f` once the clock has moved
+// `d` past its current time. The function is called by the goroutine that
+// moves the clock.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) *FakeTimer {
+	timer := &FakeTimer{clock: c, f: f}
+	timer.Reset(d)
+	return timer
+}
+
+// schedule replaces the deadline of the timer. It reports whether the timer
+// had been pending, and whether it is pending now, i.e. false when the new
+// deadline has already been reached. Timers with equal deadlines are kept in
+// order of scheduling.
+func (c *FakeClock) schedule(timer *FakeTimer, d time.Duration) (bool, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wasActive := c.remove(timer)
+	timer.when = c.now.Add(d)
+	if d <= 0 {
+		return wasActive, false
+	}
+
+	timer.active = true
+	index := sort.Search(len(c.timers), func(i int) bool {
+		return c.timers[i].when.After(timer.when)
+	})
+	c.timers = append(c.timers, nil)
+	copy(c.timers[index+1:], c.timers[index:])
+	c.timers[index] = timer
+	return wasActive, true
+}
+
+// remove drops the timer from the pending timers, and reports whether it
+// was pending. The caller must hold the lock.
+func (c *FakeClock) remove(timer *FakeTimer) bool {
+	if !timer.active {
+		return false
+	}
+	timer.active = false
+	for i, pending := range c.timers {
+		if pending == timer {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// FakeTimer is a timer of a [FakeClock]. It mirrors [time.Timer].
+type FakeTimer struct {
+	// C receives the clock's time when the timer fires. It is nil for
+	// timers created with [FakeClock.AfterFunc].
+	C <-chan time.Time
+
+	clock  *FakeClock
+	ch     chan time.Time
+	f      func()
+	when   time.Time
+	active bool
+}
+
+// Stop prevents the timer from firing. It reports whether the call stopped
+// the timer, i.e. false when the timer had already fired or been stopped.
+func (t *FakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.clock.remove(t)
+}
+
+// Reset changes the timer to fire once the clock has moved `d` past its
+// current time. It reports whether the timer had been pending.
+func (t *FakeTimer) Reset(d time.Duration) bool {
+	wasActive, pending := t.clock.schedule(t, d)
+	if !pending {
+		t.fire(t.when)
+	}
+	return wasActive
+}
+
+func (t *FakeTimer) fire(when time.Time) {
+	if t.f != nil {
+		t.f()
+		return
+	}
+	select {
+	case t.ch <- when:
+	default:
+	}
+}
@@ -0,0 +1,184 @@
+package rfc3339
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNow(t *testing.T) {
+	t.Run("uses the clock", func(t *testing.T) {
+		current := time.Date(2023, time.April, 4, 12, 30, 0, 5, time.FixedZone("EDT", -4*60*60))
+		found := Now(FixedClock(current))
+		assert.Equal(t, "2023-04-04T12:30:00.000000005-04:00", found.ToString())
+	})
+
+	t.Run("strips the monotonic reading", func(t *testing.T) {
+		found := Now(nil)
+		parsed := MustParseDateTimeString(found.Time.Format(time.RFC3339Nano))
+		assert.True(t, found.Equal(parsed.Time))
+		assert.Equal(t, found.Round(0), found.Time)
+	})
+}
+
+func TestToday(t *testing.T) {
+	// 02:30 UTC is still the previous day at -04:00.
+	clock := FixedClock(time.Date(2023, time.April, 5, 2, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		loc      *time.Location
+		expected string
+	}{
+		{name: "keeps the clock location", loc: nil, expected: "2023-04-05"},
+		{name: "converts to the location", loc: time.FixedZone("EDT", -4*60*60), expected: "2023-04-04"},
+		{name: "converts to utc", loc: time.UTC, expected: "2023-04-05"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			found := Today(clock, test.loc)
+			assert.Equal(t, MustParseDateString(test.expected), found)
+		})
+	}
+
+	t.Run("uses the system clock", func(t *testing.T) {
+		before := time.Now().UTC().Format(time.DateOnly)
+		found := TodayIn(time.UTC).ToString()
+		after := time.Now().UTC().Format(time.DateOnly)
+		assert.Contains(t, []string{before, after}, found)
+		assert.Equal(t, Today(nil, time.UTC).ToString(), found)
+	})
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2023, time.April, 4, 12, 0, 0, 0, time.UTC)
+
+	t.Run("moves only when told to", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		assert.Equal(t, start, clock.Now())
+
+		clock.Advance(90 * time.Second)
+		assert.Equal(t, start.Add(90*time.Second), clock.Now())
+
+		clock.Set(start.Add(-time.Hour))
+		assert.Equal(t, start.Add(-time.Hour), clock.Now())
+	})
+
+	t.Run("fires timers in order", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		var fired []string
+		var at []time.Time
+		record := func(name string) func() {
+			return func() {
+				fired = append(fired, name)
+				at = append(at, clock.Now())
+			}
+		}
+		clock.AfterFunc(3*time.Second, record("third"))
+		clock.AfterFunc(time.Second, record("first"))
+		clock.AfterFunc(2*time.Second, record("second-a"))
+		clock.AfterFunc(2*time.Second, record("second-b"))
+		clock.AfterFunc(time.Minute, record("later"))
+
+		clock.Advance(3 * time.Second)
+		assert.Equal(t, []string{"first", "second-a", "second-b", "third"}, fired)
+		assert.Equal(t, []time.Time{
+			start.Add(time.Second),
+			start.Add(2 * time.Second),
+			start.Add(2 * time.Second),
+			start.Add(3 * time.Second),
+		}, at)
+		assert.Equal(t, start.Add(3*time.Second), clock.Now())
+
+		clock.Set(start.Add(time.Minute))
+		assert.Equal(t, "later", fired[len(fired)-1])
+	})
+
+	t.Run("fires timers scheduled while firing", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		var fired []time.Time
+		var tick func()
+		tick = func() {
+			fired = append(fired, clock.Now())
+			clock.AfterFunc(time.Second, tick)
+		}
+		clock.AfterFunc(time.Second, tick)
+
+		clock.Advance(3 * time.Second)
+		assert.Equal(t, []time.Time{
+			start.Add(time.Second),
+			start.Add(2 * time.Second),
+			start.Add(3 * time.Second),
+		}, fired)
+	})
+
+	t.Run("sends on timer channels", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		timer := clock.NewTimer(time.Minute)
+		after := clock.After(2 * time.Minute)
+
+		clock.Advance(59 * time.Second)
+		assert.Empty(t, timer.C)
+
+		clock.Advance(2 * time.Minute)
+		require.Len(t, timer.C, 1)
+		assert.Equal(t, start.Add(time.Minute), <-timer.C)
+		assert.Equal(t, start.Add(2*time.Minute), <-after)
+	})
+
+	t.Run("fires non-positive timers immediately", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		timer := clock.NewTimer(0)
+		require.Len(t, timer.C, 1)
+		assert.Equal(t, start, <-timer.C)
+		assert.False(t, timer.Stop())
+	})
+
+	t.Run("stops and resets timers", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		timer := clock.NewTimer(time.Minute)
+		assert.True(t, timer.Stop())
+		assert.False(t, timer.Stop())
+
+		clock.Advance(time.Hour)
+		assert.Empty(t, timer.C)
+
+		assert.False(t, timer.Reset(time.Second))
+		assert.True(t, timer.Reset(time.Minute))
+		clock.Advance(time.Second)
+		assert.Empty(t, timer.C)
+		clock.Advance(time.Minute)
+		assert.Equal(t, start.Add(time.Hour+time.Minute), <-timer.C)
+	})
+
+	t.Run("does not fire when moved backward", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		timer := clock.NewTimer(time.Minute)
+		clock.Set(start.Add(-time.Hour))
+		assert.Empty(t, timer.C)
+		clock.Set(start.Add(time.Minute))
+		assert.Len(t, timer.C, 1)
+	})
+
+	t.Run("is safe for concurrent use", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i += 1 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j += 1 {
+					timer := clock.NewTimer(time.Millisecond)
+					clock.Advance(time.Millisecond)
+					timer.Stop()
+					_ = clock.Now()
+				}
+			}()
+		}
+		wg.Wait()
+		assert.True(t, clock.Now().After(start))
+	})
+}
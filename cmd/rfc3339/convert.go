@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+// Formats accepted by the -from and -to flags of the convert command.
+const (
+	formatDateTime  = "date-time"
+	formatDate      = "date"
+	formatUnix      = "unix"
+	formatUnixMilli = "unixmilli"
+	formatUnixMicro = "unixmicro"
+	formatUnixNano  = "unixnano"
+)
+
+func runConvert(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := newFlagSet("convert", "[-from format] [-to format] [-offset offset] [value ...]", stderr)
+	from := fs.String("from", formatDateTime, "the format of the values: date-time, date, unix, unixmilli, unixmicro, or unixnano")
+	to := fs.String("to", formatDateTime, "the format to convert to: date-time, date, unix, unixmilli, unixmicro, or unixnano")
+	offset := fs.String("offset", "", "the UTC offset, e.g. `+05:30` or Z, of date-time and date output; by default the offset of the value is kept, and epoch values are at UTC")
+	if ok, status := parseFlags(fs, args); !ok {
+		return status
+	}
+
+	parse, ok := convertParsers[*from]
+	if !ok {
+		fmt.Fprintf(stderr, "rfc3339 convert: unknown -from format %q\n", *from)
+		return exitUsage
+	}
+	format, ok := convertFormatters[*to]
+	if !ok {
+		fmt.Fprintf(stderr, "rfc3339 convert: unknown -to format %q\n", *to)
+		return exitUsage
+	}
+
+	var location *time.Location
+	if *offset != "" {
+		loc, err := parseOffset(*offset)
+		if err != nil {
+			fmt.Fprintf(stderr, "rfc3339 convert: %s\n", err)
+			return exitUsage
+		}
+		location = loc
+	}
+
+	return eachInput(fs.Args(), stdin, stderr, func(input string) error {
+		t, err := parse(input)
+		if err != nil {
+			return err
+		}
+		if location != nil {
+			t = t.In(location)
+		}
+		if t.Year() < 0 || t.Year() > 9999 {
+			return fmt.Errorf("`%s` is outside the years 0000 through 9999", input)
+		}
+		if *to == formatUnixNano && (t.Before(minUnixNano) || t.After(maxUnixNano)) {
+			return fmt.Errorf("`%s` is outside the range of -to unixnano", input)
+		}
+		fmt.Fprintln(stdout, format(t))
+		return nil
+	})
+}
+
+// The earliest and latest instants that a unixnano value can represent.
+var (
+	minUnixNano = time.Unix(0, math.MinInt64)
+	maxUnixNano = time.Unix(0, math.MaxInt64)
+)
+
+var convertParsers = map[string]func(input string) (time.Time, error){
+	formatDateTime: func(input string) (time.Time, error) {
+		dt, err := rfc3339.NewDateTimeFromString(input)
+		return dt.Time, err
+	},
+	formatDate: func(input string) (time.Time, error) {
+		fd, err := rfc3339.NewFullDateFromString(input)
+		return fd.Time, err
+	},
+	formatUnix:      epochParser(time.Second),
+	formatUnixMilli: epochParser(time.Millisecond),
+	formatUnixMicro: epochParser(time.Microsecond),
+	formatUnixNano:  epochParser(time.Nanosecond),
+}
+
+var convertFormatters = map[string]func(t time.Time) string{
+	formatDateTime: func(t time.Time) string {
+		return rfc3339.NewFromTime(t).ToString()
+	},
+	formatDate: func(t time.Time) string {
+		return rfc3339.FullDate{Time: t}.ToString()
+	},
+	formatUnix: func(t time.Time) string {
+		return strconv.FormatInt(t.Unix(), 10)
+	},
+	formatUnixMilli: func(t time.Time) string {
+		return strconv.FormatInt(t.UnixMilli(), 10)
+	},
+	formatUnixMicro: func(t time.Time) string {
+		return strconv.FormatInt(t.UnixMicro(), 10)
+	},
+	formatUnixNano: func(t time.Time) string {
+		return strconv.FormatInt(t.UnixNano(), 10)
+	},
+}
+
+// epochParser creates a parser of integer epoch values counted in units.
+// The resulting times are at UTC.
+func epochParser(unit time.Duration) func(input string) (time.Time, error) {
+	return func(input string) (time.Time, error) {
+		value, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("`%s` is not an integer epoch value", input)
+		}
+
+		perSecond := int64(time.Second / unit)
+		seconds := value / perSecond
+		remainder := value % perSecond
+		if remainder < 0 {
+			seconds -= 1
+			remainder += perSecond
+		}
+		return time.Unix(seconds, remainder*int64(unit)).UTC(), nil
+	}
+}
+
+// parseOffset parses a UTC offset of the form `+hh:mm`, `-hh:mm`, or `Z`.
+func parseOffset(input string) (*time.Location, error) {
+	if strings.EqualFold(input, "Z") {
+		return time.UTC, nil
+	}
+
+	// Reuse the date-time parser so that offsets follow the same rules.
+	dt, err := rfc3339.NewDateTimeFromString("2000-01-01T00:00:00" + input)
+	if err != nil {
+		return nil, fmt.Errorf("`%s` is not a UTC offset", input)
+	}
+	_, seconds := dt.Zone()
+	return rfc3339.LocationFromOffset(seconds), nil
+}
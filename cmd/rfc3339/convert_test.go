@@ -0,0 +1,94 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "date-time to unix", args: []string{"-to", "unix", "2023-04-04T12:30:00.9-04:00"}, expected: "1680625800"},
+		{name: "date-time to unixmilli", args: []string{"-to", "unixmilli", "2023-04-04T12:30:00.9-04:00"}, expected: "1680625800900"},
+		{name: "date-time to unixmicro", args: []string{"-to", "unixmicro", "2023-04-04T12:30:00.000001Z"}, expected: "1680611400000001"},
+		{name: "date-time to unixnano", args: []string{"-to", "unixnano", "2023-04-04T12:30:00.000000001Z"}, expected: "1680611400000000001"},
+		{name: "date-time to date", args: []string{"-to", "date", "2023-04-04T23:30:00-04:00"}, expected: "2023-04-04"},
+		{name: "date-time to date at offset", args: []string{"-to", "date", "-offset", "Z", "2023-04-04T23:30:00-04:00"}, expected: "2023-04-05"},
+		{name: "date-time to offset", args: []string{"-offset", "+05:30", "2023-04-04T12:30:00Z"}, expected: "2023-04-04T18:00:00+05:30"},
+		{name: "unix to date-time", args: []string{"-from", "unix", "1680611400"}, expected: "2023-04-04T12:30:00Z"},
+		{name: "unixmilli to date-time at offset", args: []string{"-from", "unixmilli", "-offset", "-04:00", "--", "-1"}, expected: "1969-12-31T19:59:59.999-04:00"},
+		{name: "unixnano to date-time", args: []string{"-from", "unixnano", "1680611400000000001"}, expected: "2023-04-04T12:30:00.000000001Z"},
+		{name: "date to unix", args: []string{"-from", "date", "-to", "unix", "2023-04-04"}, expected: "1680566400"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			found := runCLI("", append([]string{"convert"}, test.args...)...)
+			assert.Equal(t, result{status: exitOK, stdout: test.expected + "\n"}, found)
+		})
+	}
+
+	t.Run("converts stdin", func(t *testing.T) {
+		found := runCLI("1680611400\nsoon\n0\n", "convert", "-from", "unix")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stdout: "2023-04-04T12:30:00Z\n1970-01-01T00:00:00Z\n",
+			stderr: "line 2: `soon` is not an integer epoch value\n",
+		}, found)
+	})
+
+	t.Run("rejects years outside of RFC 3339", func(t *testing.T) {
+		found := runCLI("", "convert", "-from", "unix", "253402300799", "253402300800")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stdout: "9999-12-31T23:59:59Z\n",
+			stderr: "argument 2: `253402300800` is outside the years 0000 through 9999\n",
+		}, found)
+
+		found = runCLI("", "convert", "-from", "unix", "-to", "date", "--", "-62167219201", "soon")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stderr: "argument 1: `-62167219201` is outside the years 0000 through 9999\n" +
+				"argument 2: `soon` is not an integer epoch value\n",
+		}, found)
+
+		found = runCLI("", "convert", "-from", "unix", "-to", "unixmilli", "253402300800")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stderr: "argument 1: `253402300800` is outside the years 0000 through 9999\n",
+		}, found)
+
+		found = runCLI("", "convert", "-offset", "+01:00", "9999-12-31T23:30:00Z")
+		assert.Equal(t, exitInvalid, found.status)
+	})
+
+	t.Run("rejects instants outside of unixnano", func(t *testing.T) {
+		found := runCLI("", "convert", "-to", "unixnano", "2262-04-11T23:47:16.854775807Z", "9999-01-01T00:00:00Z")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stdout: "9223372036854775807\n",
+			stderr: "argument 2: `9999-01-01T00:00:00Z` is outside the range of -to unixnano\n",
+		}, found)
+
+		found = runCLI("", "convert", "-to", "unixnano", "1677-09-21T00:12:43.145224191Z")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stderr: "argument 1: `1677-09-21T00:12:43.145224191Z` is outside the range of -to unixnano\n",
+		}, found)
+	})
+
+	t.Run("rejects invalid flags", func(t *testing.T) {
+		found := runCLI("", "convert", "-from", "iso")
+		assert.Equal(t, result{status: exitUsage, stderr: "rfc3339 convert: unknown -from format \"iso\"\n"}, found)
+
+		found = runCLI("", "convert", "-to", "iso")
+		assert.Equal(t, result{status: exitUsage, stderr: "rfc3339 convert: unknown -to format \"iso\"\n"}, found)
+
+		found = runCLI("", "convert", "-offset", "+0530")
+		assert.Equal(t, result{status: exitUsage, stderr: "rfc3339 convert: `+0530` is not a UTC offset\n"}, found)
+	})
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+func runDiff(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := newFlagSet("diff", "[-seconds] [start end]", stderr)
+	seconds := fs.Bool("seconds", false, "print the duration as a number of seconds")
+	if ok, status := parseFlags(fs, args); !ok {
+		return status
+	}
+
+	// Pairs given as arguments are joined so that they are handled the same
+	// way as a line of stdin.
+	var inputs []string
+	switch len(fs.Args()) {
+	case 0:
+	case 2:
+		inputs = []string{strings.Join(fs.Args(), " ")}
+	default:
+		fs.Usage()
+		return exitUsage
+	}
+
+	return eachInput(inputs, stdin, stderr, func(input string) error {
+		fields := strings.Fields(input)
+		if len(fields) != 2 {
+			return fmt.Errorf("expected two values, found %d", len(fields))
+		}
+
+		start, err := parseDateTimeOrDate(fields[0])
+		if err != nil {
+			return err
+		}
+		end, err := parseDateTimeOrDate(fields[1])
+		if err != nil {
+			return err
+		}
+
+		// Sub saturates durations that exceed about 292 years.
+		duration := end.Sub(start)
+		if duration == math.MaxInt64 || duration == math.MinInt64 {
+			return fmt.Errorf("the duration between `%s` and `%s` is out of range", fields[0], fields[1])
+		}
+		if *seconds {
+			fmt.Fprintln(stdout, duration.Seconds())
+		} else {
+			fmt.Fprintln(stdout, duration)
+		}
+		return nil
+	})
+}
+
+// parseDateTimeOrDate parses a date-time, or a full-date as midnight UTC.
+func parseDateTimeOrDate(input string) (time.Time, error) {
+	if rfc3339.IsFullDateString(input) {
+		fd, err := rfc3339.NewFullDateFromString(input)
+		return fd.Time, err
+	}
+
+	dt, err := rfc3339.NewDateTimeFromString(input)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("`%s` is not a date-time or full-date string", input)
+	}
+	return dt.Time, nil
+}
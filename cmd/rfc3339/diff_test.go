@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	t.Run("compares arguments", func(t *testing.T) {
+		found := runCLI("", "diff", "2023-04-04T12:30:00-04:00", "2023-04-04T18:45:30.5Z")
+		assert.Equal(t, result{status: exitOK, stdout: "2h15m30.5s\n"}, found)
+	})
+
+	t.Run("compares dates", func(t *testing.T) {
+		found := runCLI("", "diff", "-seconds", "2023-04-05", "2023-04-04")
+		assert.Equal(t, result{status: exitOK, stdout: "-86400\n"}, found)
+	})
+
+	t.Run("compares stdin pairs", func(t *testing.T) {
+		found := runCLI("2023-04-04 2023-04-04T00:01:00Z\n2023-04-04\n2023-04-04 later\n", "diff")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stdout: "1m0s\n",
+			stderr: "line 2: expected two values, found 1\nline 3: `later` is not a date-time or full-date string\n",
+		}, found)
+	})
+
+	t.Run("rejects durations out of range", func(t *testing.T) {
+		found := runCLI("", "diff", "0001-01-01T00:00:00Z", "9999-01-01T00:00:00Z")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stderr: "argument 1: the duration between `0001-01-01T00:00:00Z` and `9999-01-01T00:00:00Z` is out of range\n",
+		}, found)
+
+		found = runCLI("", "diff", "-seconds", "9999-01-01", "0001-01-01")
+		assert.Equal(t, exitInvalid, found.status)
+	})
+
+	t.Run("requires a pair of arguments", func(t *testing.T) {
+		found := runCLI("", "diff", "2023-04-04")
+		assert.Equal(t, exitUsage, found.status)
+		assert.Contains(t, found.stderr, "usage: rfc3339 diff")
+	})
+}
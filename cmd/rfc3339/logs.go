@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/jsumners/go-rfc3339/rfc3339log"
+)
+
+func runLogs(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := newFlagSet("logs", "[-offset offset] [-since date-time] [-until date-time] [-sort] [file ...]", stderr)
+	offset := fs.String("offset", "", "rewrite every date-time into the UTC offset, e.g. `+05:30` or Z")
+	var since, until rfc3339.DateTime
+	rfc3339.FlagSet{FlagSet: fs}.DateTimeVar(&since, "since", rfc3339.DateTime{}, "keep lines at or after the date-time; accepts now, today, and yesterday")
+	rfc3339.FlagSet{FlagSet: fs}.DateTimeVar(&until, "until", rfc3339.DateTime{}, "keep lines before the date-time; accepts now, today, and yesterday")
+	sort := fs.Bool("sort", false, "sort lines by their first date-time; this holds all lines in memory")
+	if ok, status := parseFlags(fs, args); !ok {
+		return status
+	}
+
+	var opts []rfc3339log.Option
+	if *offset != "" {
+		loc, err := parseOffset(*offset)
+		if err != nil {
+			fmt.Fprintf(stderr, "rfc3339 logs: %s\n", err)
+			return exitUsage
+		}
+		opts = append(opts, rfc3339log.WithOffset(loc))
+	}
+	if !since.IsZero() {
+		opts = append(opts, rfc3339log.WithSince(since))
+	}
+	if !until.IsZero() {
+		opts = append(opts, rfc3339log.WithUntil(until))
+	}
+	if *sort {
+		opts = append(opts, rfc3339log.WithSort())
+	}
+
+	if fs.NArg() == 0 {
+		if err := rfc3339log.Process(stdin, stdout, opts...); err != nil {
+			fmt.Fprintf(stderr, "rfc3339 logs: %s\n", err)
+			return exitInvalid
+		}
+		return exitOK
+	}
+
+	// Files are processed as one stream so that sorting applies across
+	// all of them. Each file but the last is terminated with a newline so
+	// that its last line is not joined with the first line of the next.
+	readers := make([]io.Reader, 0, fs.NArg())
+	for i, name := range fs.Args() {
+		file, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "rfc3339 logs: %s\n", err)
+			return exitInvalid
+		}
+		defer file.Close()
+		if i < fs.NArg()-1 {
+			readers = append(readers, &terminatedReader{r: file})
+		} else {
+			readers = append(readers, file)
+		}
+	}
+
+	if err := rfc3339log.Process(io.MultiReader(readers...), stdout, opts...); err != nil {
+		fmt.Fprintf(stderr, "rfc3339 logs: %s\n", err)
+		return exitInvalid
+	}
+	return exitOK
+}
+
+// terminatedReader reads r and, if r is not empty and does not end with a
+// newline, appends one.
+type terminatedReader struct {
+	r    io.Reader
+	last byte
+	eof  bool
+}
+
+func (t *terminatedReader) Read(p []byte) (int, error) {
+	if t.eof {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		if t.last == 0 || t.last == '\n' {
+			return 0, io.EOF
+		}
+		p[0] = '\n'
+		t.last = '\n'
+		return 1, io.EOF
+	}
+
+	n, err := t.r.Read(p)
+	if n > 0 {
+		t.last = p[n-1]
+	}
+	if err != io.EOF {
+		return n, err
+	}
+	t.eof = true
+	if n > 0 {
+		return n, nil
+	}
+	return t.Read(p)
+}
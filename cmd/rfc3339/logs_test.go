@@ -0,0 +1,70 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogs(t *testing.T) {
+	input := "2023-04-04T12:30:00-04:00 a\n  detail\n2023-04-04T16:00:00Z b\n2023-04-04T17:00:00.5Z c\n"
+
+	t.Run("rewrites offsets", func(t *testing.T) {
+		found := runCLI(input, "logs", "-offset", "Z")
+		assert.Equal(t, result{
+			status: exitOK,
+			stdout: "2023-04-04T16:30:00Z a\n  detail\n2023-04-04T16:00:00Z b\n2023-04-04T17:00:00.5Z c\n",
+		}, found)
+	})
+
+	t.Run("filters and sorts", func(t *testing.T) {
+		found := runCLI(input, "logs", "-sort", "-since", "2023-04-04T16:00:00Z", "-until", "2023-04-04T17:00:00Z")
+		assert.Equal(t, result{
+			status: exitOK,
+			stdout: "2023-04-04T16:00:00Z b\n2023-04-04T12:30:00-04:00 a\n  detail\n",
+		}, found)
+	})
+
+	t.Run("accepts shortcuts", func(t *testing.T) {
+		recent := time.Now().UTC().Format(time.RFC3339) + " recent\n"
+		found := runCLI(input+recent, "logs", "-since", "yesterday")
+		assert.Equal(t, result{status: exitOK, stdout: recent}, found)
+	})
+
+	t.Run("reads files", func(t *testing.T) {
+		dir := t.TempDir()
+		first := filepath.Join(dir, "first.log")
+		second := filepath.Join(dir, "second.log")
+		require.NoError(t, os.WriteFile(first, []byte("2023-04-04T12:00:00Z first\n"), 0o600))
+		require.NoError(t, os.WriteFile(second, []byte("2023-04-04T11:00:00Z second\n"), 0o600))
+
+		found := runCLI("", "logs", "-sort", first, second)
+		assert.Equal(t, result{status: exitOK, stdout: "2023-04-04T11:00:00Z second\n2023-04-04T12:00:00Z first\n"}, found)
+
+		require.NoError(t, os.WriteFile(first, []byte("2023-04-04T12:00:00Z first"), 0o600))
+		found = runCLI("", "logs", "-sort", first, second)
+		assert.Equal(t, result{status: exitOK, stdout: "2023-04-04T11:00:00Z second\n2023-04-04T12:00:00Z first\n"}, found)
+
+		empty := filepath.Join(dir, "empty.log")
+		require.NoError(t, os.WriteFile(empty, nil, 0o600))
+		found = runCLI("", "logs", empty, second, first)
+		assert.Equal(t, result{status: exitOK, stdout: "2023-04-04T11:00:00Z second\n2023-04-04T12:00:00Z first"}, found)
+
+		found = runCLI("", "logs", filepath.Join(dir, "missing.log"))
+		assert.Equal(t, exitInvalid, found.status)
+		assert.Contains(t, found.stderr, "missing.log")
+	})
+
+	t.Run("rejects invalid flags", func(t *testing.T) {
+		found := runCLI("", "logs", "-since", "last week")
+		assert.Equal(t, exitUsage, found.status)
+		assert.Contains(t, found.stderr, "input is not a date-time string: last week")
+
+		found = runCLI("", "logs", "-offset", "EST")
+		assert.Equal(t, result{status: exitUsage, stderr: "rfc3339 logs: `EST` is not a UTC offset\n"}, found)
+	})
+}
@@ -0,0 +1,153 @@
+// Command rfc3339 validates, normalizes, converts, and compares RFC 3339
+// timestamps. Most subcommands operate on the values given as arguments or,
+// when there are none, on each line read from stdin, so that they can be used
+// in shell pipelines. The logs subcommand reads whole log files, or stdin,
+// and processes the date-times embedded in each line:
+//
+//	rfc3339 validate [-type date-time|date|time|duration] [-v] [value ...]
+//	rfc3339 normalize [-type date-time|date] [-utc] [-precision n] [value ...]
+//	rfc3339 convert [-from format] [-to format] [-offset offset] [value ...]
+//	rfc3339 diff [start end]
+//	rfc3339 now [-utc] [-precision n] [-date]
+//	rfc3339 logs [-offset offset] [-since date-time] [-until date-time] [-sort] [file ...]
+//
+// The exit status is 0 on success, 1 when any value is invalid, and 2 for
+// usage errors.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+const (
+	exitOK      = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// clock provides the current time for the `now` subcommand.
+var clock = rfc3339.SystemClock
+
+// command is a subcommand of the tool.
+type command struct {
+	name    string
+	summary string
+	run     func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
+}
+
+var commands = []command{
+	{name: "validate", summary: "verify values are RFC 3339 strings", run: runValidate},
+	{name: "normalize", summary: "rewrite values into their canonical form", run: runNormalize},
+	{name: "convert", summary: "convert values to and from unix epochs, offsets, and dates", run: runConvert},
+	{name: "diff", summary: "print the duration between two values", run: runDiff},
+	{name: "now", summary: "print the current date-time", run: runNow},
+	{name: "logs", summary: "rewrite, filter, and sort log lines by their date-times", run: runLogs},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return exitOK
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "rfc3339: unknown command %q\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: rfc3339 <command> [flags] [value ...]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Values are read from stdin, one per line, when none are given.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run `rfc3339 <command> -h` for the flags of a command.")
+}
+
+// newFlagSet creates the flag set for a subcommand.
+func newFlagSet(name string, usage string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: rfc3339 %s %s\n", name, usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses the subcommand's flags. It returns false, along with
+// the exit status, when the command should not continue.
+func parseFlags(fs *flag.FlagSet, args []string) (bool, int) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return false, exitOK
+		}
+		return false, exitUsage
+	}
+	return true, exitOK
+}
+
+// eachInput invokes fn with each of the values given as arguments or, when
+// there are none, with each non-blank line read from stdin. The source
+// identifies the value in error messages, e.g. `line 3` or `argument 1`.
+// Errors returned by fn are written to stderr, and processing continues
+// with the next value. The result is the exit status.
+func eachInput(args []string, stdin io.Reader, stderr io.Writer, fn func(input string) error) int {
+	status := exitOK
+	handle := func(source string, input string) {
+		if err := fn(input); err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", source, err)
+			status = exitInvalid
+		}
+	}
+
+	if len(args) > 0 {
+		for i, arg := range args {
+			handle(fmt.Sprintf("argument %d", i+1), arg)
+		}
+		return status
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for line := 1; scanner.Scan(); line += 1 {
+		input := strings.TrimSpace(scanner.Text())
+		if input == "" {
+			continue
+		}
+		handle(fmt.Sprintf("line %d", line), input)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "rfc3339: reading stdin: %s\n", err)
+		return exitInvalid
+	}
+
+	return status
+}
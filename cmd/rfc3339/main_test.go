@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type result struct {
+	status int
+	stdout string
+	stderr string
+}
+
+func runCLI(stdin string, args ...string) result {
+	var stdout, stderr strings.Builder
+	status := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return result{status: status, stdout: stdout.String(), stderr: stderr.String()}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("prints usage without a command", func(t *testing.T) {
+		found := runCLI("")
+		assert.Equal(t, exitUsage, found.status)
+		assert.Contains(t, found.stderr, "usage: rfc3339 <command> [flags] [value ...]")
+	})
+
+	t.Run("prints help", func(t *testing.T) {
+		found := runCLI("", "help")
+		assert.Equal(t, exitOK, found.status)
+		for _, cmd := range commands {
+			assert.Contains(t, found.stdout, cmd.name)
+		}
+	})
+
+	t.Run("rejects unknown commands", func(t *testing.T) {
+		found := runCLI("", "parse")
+		assert.Equal(t, exitUsage, found.status)
+		assert.Contains(t, found.stderr, `rfc3339: unknown command "parse"`)
+	})
+
+	t.Run("prints command help", func(t *testing.T) {
+		found := runCLI("", "validate", "-h")
+		assert.Equal(t, exitOK, found.status)
+		assert.Contains(t, found.stderr, "usage: rfc3339 validate")
+	})
+
+	t.Run("rejects unknown flags", func(t *testing.T) {
+		found := runCLI("", "validate", "-strict")
+		assert.Equal(t, exitUsage, found.status)
+		assert.Contains(t, found.stderr, "flag provided but not defined: -strict")
+	})
+}
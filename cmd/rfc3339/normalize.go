@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+func runNormalize(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := newFlagSet("normalize", "[-type date-time|date] [-utc] [-precision n] [value ...]", stderr)
+	kind := fs.String("type", "date-time", "the RFC 3339 production of the values: date-time or date")
+	utc := fs.Bool("utc", false, "convert date-time values to UTC")
+	precision := fs.Int("precision", -1, "the number of fractional second digits of date-time values; -1 keeps all significant digits")
+	if ok, status := parseFlags(fs, args); !ok {
+		return status
+	}
+
+	var normalize func(input string) (string, error)
+	switch *kind {
+	case "date-time":
+		normalize = func(input string) (string, error) {
+			dt, err := rfc3339.NewDateTimeFromString(input)
+			if err != nil {
+				return "", err
+			}
+			if *utc {
+				dt = rfc3339.NewFromTime(dt.UTC())
+			}
+			return formatPrecision(dt, *precision), nil
+		}
+	case "date":
+		normalize = func(input string) (string, error) {
+			fd, err := rfc3339.NewFullDateFromString(input)
+			if err != nil {
+				return "", err
+			}
+			return fd.ToString(), nil
+		}
+	default:
+		fmt.Fprintf(stderr, "rfc3339 normalize: unknown type %q\n", *kind)
+		return exitUsage
+	}
+
+	return eachInput(fs.Args(), stdin, stderr, func(input string) error {
+		normalized, err := normalize(input)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(stdout, normalized)
+		return nil
+	})
+}
+
+// formatPrecision formats a date-time with the given number of fractional
+// second digits, or with all significant digits if precision is negative.
+func formatPrecision(dt rfc3339.DateTime, precision int) string {
+	if precision < 0 {
+		return dt.ToString()
+	}
+	return dt.ToStringPrecision(precision)
+}
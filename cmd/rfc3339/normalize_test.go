@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("rewrites into canonical form", func(t *testing.T) {
+		found := runCLI("2023-04-04t12:30:00.500z\n2023-04-04T12:30:00+00:00\n", "normalize")
+		assert.Equal(t, result{status: exitOK, stdout: "2023-04-04T12:30:00.5Z\n2023-04-04T12:30:00Z\n"}, found)
+	})
+
+	t.Run("converts to UTC with a precision", func(t *testing.T) {
+		found := runCLI("", "normalize", "-utc", "-precision", "3", "2023-04-04T12:30:00.123456-04:00")
+		assert.Equal(t, result{status: exitOK, stdout: "2023-04-04T16:30:00.123Z\n"}, found)
+
+		found = runCLI("", "normalize", "-precision", "0", "2023-04-04T12:30:00.9+05:30")
+		assert.Equal(t, result{status: exitOK, stdout: "2023-04-04T12:30:00+05:30\n"}, found)
+	})
+
+	t.Run("continues after invalid values", func(t *testing.T) {
+		found := runCLI("bad\n2023-04-04T12:30:00Z\n", "normalize")
+		assert.Equal(t, result{
+			status: exitInvalid,
+			stdout: "2023-04-04T12:30:00Z\n",
+			stderr: "line 1: input is not a date-time string: bad\n",
+		}, found)
+	})
+
+	t.Run("normalizes dates", func(t *testing.T) {
+		found := runCLI("2023-04-04\n2023-04-04T00:00:00Z\n", "normalize", "-type", "date")
+		assert.Equal(t, exitInvalid, found.status)
+		assert.Equal(t, "2023-04-04\n", found.stdout)
+		assert.Equal(t, "line 2: `2023-04-04T00:00:00Z` is not a full-date string\n", found.stderr)
+	})
+
+	t.Run("rejects unknown types", func(t *testing.T) {
+		found := runCLI("", "normalize", "-type", "time")
+		assert.Equal(t, exitUsage, found.status)
+	})
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+func runNow(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := newFlagSet("now", "[-utc] [-precision n] [-date]", stderr)
+	utc := fs.Bool("utc", false, "print the time at UTC instead of the local offset")
+	precision := fs.Int("precision", -1, "the number of fractional second digits; -1 keeps all significant digits")
+	date := fs.Bool("date", false, "print the current full-date")
+	if ok, status := parseFlags(fs, args); !ok {
+		return status
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	var loc *time.Location
+	if *utc {
+		loc = time.UTC
+	}
+
+	if *date {
+		fmt.Fprintln(stdout, rfc3339.Today(clock, loc).ToString())
+		return exitOK
+	}
+	current := rfc3339.Now(clock)
+	if loc != nil {
+		current = rfc3339.NewFromTime(current.In(loc))
+	}
+	fmt.Fprintln(stdout, formatPrecision(current, *precision))
+
+	return exitOK
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNow(t *testing.T) {
+	original := clock
+	t.Cleanup(func() { clock = original })
+	clock = rfc3339.FixedClock(time.Date(2023, time.April, 4, 22, 30, 0, 123456789, time.FixedZone("EDT", -4*60*60)))
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{args: nil, expected: "2023-04-04T22:30:00.123456789-04:00"},
+		{args: []string{"-utc"}, expected: "2023-04-05T02:30:00.123456789Z"},
+		{args: []string{"-precision", "0"}, expected: "2023-04-04T22:30:00-04:00"},
+		{args: []string{"-utc", "-precision", "3"}, expected: "2023-04-05T02:30:00.123Z"},
+		{args: []string{"-date"}, expected: "2023-04-04"},
+		{args: []string{"-date", "-utc"}, expected: "2023-04-05"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			found := runCLI("", append([]string{"now"}, test.args...)...)
+			assert.Equal(t, result{status: exitOK, stdout: test.expected + "\n"}, found)
+		})
+	}
+
+	t.Run("rejects arguments", func(t *testing.T) {
+		found := runCLI("", "now", "tomorrow")
+		assert.Equal(t, exitUsage, found.status)
+	})
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jsumners/go-rfc3339/rfc3339format"
+)
+
+func runValidate(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := newFlagSet("validate", "[-type date-time|date|time|duration] [-v] [value ...]", stderr)
+	kind := fs.String("type", rfc3339format.FormatDateTime, "the RFC 3339 production values must match: date-time, date, time, or duration")
+	verbose := fs.Bool("v", false, "print each valid value")
+	if ok, status := parseFlags(fs, args); !ok {
+		return status
+	}
+
+	validate, ok := rfc3339format.Lookup(*kind)
+	if !ok {
+		fmt.Fprintf(stderr, "rfc3339 validate: unknown type %q\n", *kind)
+		return exitUsage
+	}
+
+	return eachInput(fs.Args(), stdin, stderr, func(input string) error {
+		if err := validate(input); err != nil {
+			return err
+		}
+		if *verbose {
+			fmt.Fprintln(stdout, input)
+		}
+		return nil
+	})
+}
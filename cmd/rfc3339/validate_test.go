@@ -0,0 +1,39 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("accepts valid stdin", func(t *testing.T) {
+		found := runCLI("2023-04-04T12:30:00Z\n\n  2023-04-04T12:30:00-04:00  \n", "validate")
+		assert.Equal(t, result{status: exitOK}, found)
+	})
+
+	t.Run("reports invalid lines", func(t *testing.T) {
+		found := runCLI("2023-04-04T12:30:00Z\n2023-04-04\nnow\n", "validate")
+		assert.Equal(t, exitInvalid, found.status)
+		assert.Equal(t, "line 2: input is not a date-time string: 2023-04-04\nline 3: input is not a date-time string: now\n", found.stderr)
+	})
+
+	t.Run("validates arguments", func(t *testing.T) {
+		found := runCLI("ignored\n", "validate", "-type", "date", "-v", "2023-04-04", "2023-4-4")
+		assert.Equal(t, exitInvalid, found.status)
+		assert.Equal(t, "2023-04-04\n", found.stdout)
+		assert.Equal(t, "argument 2: `2023-4-4` is not a full-date string\n", found.stderr)
+	})
+
+	t.Run("validates other types", func(t *testing.T) {
+		assert.Equal(t, exitOK, runCLI("12:30:00Z\n", "validate", "-type", "time").status)
+		assert.Equal(t, exitOK, runCLI("P1DT2H\n", "validate", "-type", "duration").status)
+		assert.Equal(t, exitInvalid, runCLI("12:30:00\n", "validate", "-type", "time").status)
+	})
+
+	t.Run("rejects unknown types", func(t *testing.T) {
+		found := runCLI("", "validate", "-type", "email")
+		assert.Equal(t, exitUsage, found.status)
+		assert.Equal(t, "rfc3339 validate: unknown type \"email\"\n", found.stderr)
+	})
+}
@@ -0,0 +1,111 @@
+package rfc3339
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Verdicts of the conformance corpus. See testdata/conformance.
+const (
+	verdictValid   = "valid"
+	verdictInvalid = "invalid"
+	verdictLenient = "lenient"
+)
+
+// conformanceCase is an entry of the conformance corpus.
+type conformanceCase struct {
+	Line    int
+	Verdict string
+	Input   string
+}
+
+// loadConformance reads the corpus file testdata/conformance/<name>.txt.
+func loadConformance(tb testing.TB, name string) []conformanceCase {
+	tb.Helper()
+	file, err := os.Open(filepath.Join("testdata", "conformance", name+".txt"))
+	require.NoError(tb, err)
+	defer file.Close()
+
+	var cases []conformanceCase
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line += 1 {
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		verdict, input, _ := strings.Cut(text, " ")
+		input = strings.TrimLeft(input, " ")
+		if strings.HasPrefix(input, `"`) {
+			input, err = strconv.Unquote(input)
+			require.NoError(tb, err, "line %d", line)
+		}
+		switch verdict {
+		case verdictValid, verdictInvalid, verdictLenient:
+		default:
+			require.Failf(tb, "unknown verdict", "line %d: %s", line, verdict)
+		}
+
+		cases = append(cases, conformanceCase{Line: line, Verdict: verdict, Input: input})
+	}
+	require.NoError(tb, scanner.Err())
+	require.NotEmpty(tb, cases)
+
+	return cases
+}
+
+// sameInstant reports whether two times are the same instant at the same
+// UTC offset.
+func sameInstant(a time.Time, b time.Time) bool {
+	_, aOffset := a.Zone()
+	_, bOffset := b.Zone()
+	return a.Equal(b) && aOffset == bOffset
+}
+
+func TestConformance_DateTime(t *testing.T) {
+	for _, test := range loadConformance(t, "date-time") {
+		t.Run(strconv.Quote(test.Input), func(t *testing.T) {
+			found, err := NewDateTimeFromString(test.Input)
+			assert.Equal(t, test.Verdict != verdictInvalid, IsDateTimeString(test.Input))
+
+			switch test.Verdict {
+			case verdictInvalid:
+				assert.Error(t, err, "line %d", test.Line)
+			case verdictLenient:
+				assert.NoError(t, err, "line %d", test.Line)
+			case verdictValid:
+				require.NoError(t, err, "line %d", test.Line)
+				reparsed, err := NewDateTimeFromString(found.ToString())
+				require.NoError(t, err, "line %d", test.Line)
+				assert.True(t, sameInstant(found.Time, reparsed.Time), "line %d", test.Line)
+			}
+		})
+	}
+}
+
+func TestConformance_FullDate(t *testing.T) {
+	for _, test := range loadConformance(t, "full-date") {
+		t.Run(strconv.Quote(test.Input), func(t *testing.T) {
+			found, err := NewFullDateFromString(test.Input)
+			assert.Equal(t, test.Verdict != verdictInvalid, IsFullDateString(test.Input))
+
+			switch test.Verdict {
+			case verdictInvalid:
+				assert.Error(t, err, "line %d", test.Line)
+			case verdictLenient:
+				assert.NoError(t, err, "line %d", test.Line)
+			case verdictValid:
+				require.NoError(t, err, "line %d", test.Line)
+				assert.Equal(t, test.Input, found.ToString(), "line %d", test.Line)
+			}
+		})
+	}
+}
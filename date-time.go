@@ -2,11 +2,15 @@ package rfc3339
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/jsumners/go-reggie"
+	"gopkg.in/yaml.v3"
 )
 
 var regexParts = []string{
@@ -25,6 +29,16 @@ var regexParts = []string{
 	")$",
 }
 
+// dateTimeBinaryVersion identifies the layout written by
+// [DateTime.MarshalBinary]. It must be incremented whenever the layout
+// changes so that previously encoded data can still be decoded.
+const dateTimeBinaryVersion byte = 1
+
+// dateTimeBinaryLen is the length of a version 1 binary encoded [DateTime]:
+// 1 byte version, 8 bytes Unix seconds, 4 bytes nanoseconds, and 4 bytes
+// UTC offset in seconds.
+const dateTimeBinaryLen = 17
+
 var dateTimeRegex = reggie.MustCompile(
 	strings.Join(regexParts, ""),
 )
@@ -73,7 +87,7 @@ func NewDateTimeFromString(input string) (DateTime, error) {
 	offsetTime := dateTimeRegex.SubmatchWithName("offsetTime")
 	if offsetTime == "" {
 		offsetZString := dateTimeRegex.SubmatchWithName("offsetZ")
-		if offsetZString == "Z" {
+		if strings.EqualFold(offsetZString, "Z") {
 			offsetTime = "+00:00"
 		}
 	}
@@ -115,12 +129,82 @@ func NewFromTime(time time.Time) DateTime {
 	return DateTime{Time: time}
 }
 
+// LocationFromOffset converts a UTC offset, in seconds, to a [time.Location].
+// A zero offset results in [time.UTC]. Any other offset results in a fixed
+// zone named for the offset, e.g. `UTC-04:00`, matching the zones created
+// by [NewDateTimeFromString].
+func LocationFromOffset(offset int) *time.Location {
+	if offset == 0 {
+		return time.UTC
+	}
+
+	sign := '+'
+	abs := offset
+	if offset < 0 {
+		sign = '-'
+		abs = -offset
+	}
+
+	name := fmt.Sprintf("UTC%c%02d:%02d", sign, abs/3600, abs/60%60)
+	if abs%60 != 0 {
+		name = fmt.Sprintf("%s:%02d", name, abs%60)
+	}
+
+	return time.FixedZone(name, offset)
+}
+
+// IsZero reports whether the [DateTime] represents the zero time instant,
+// January 1, year 1, 00:00:00 UTC. The offset of the instance is not
+// considered. Zero instances serialize to JSON `null`, and are omitted by
+// the `omitzero` JSON struct tag option of Go 1.24 and later.
+func (dt DateTime) IsZero() bool {
+	return dt.Time.IsZero()
+}
+
 // ToString serializes the [DateTime] instance to a full RFC 3339 date-time
 // string representation.
 func (dt DateTime) ToString() string {
 	return dt.Format(time.RFC3339Nano)
 }
 
+// ToStringPrecision serializes the [DateTime] instance to an RFC 3339
+// date-time string representation with exactly `digits` fractional second
+// digits, truncating or zero padding as needed. Fractional seconds are
+// omitted when `digits` is not positive, and limited to 9 digits. It stands
+// in for the precision of a `%.3v` style verb: [DateTime] does not implement
+// [fmt.Formatter], whose Format method would hide [time.Time.Format].
+func (dt DateTime) ToStringPrecision(digits int) string {
+	return dt.Format(dateTimeLayout(digits))
+}
+
+// String implements [fmt.Stringer]. It is equivalent to [DateTime.ToString].
+func (dt DateTime) String() string {
+	return dt.ToString()
+}
+
+// GoString implements [fmt.GoStringer] so that `%#v` writes a Go expression
+// that recreates the value. Offsets with seconds, e.g. local mean time
+// offsets, cannot be written in a date-time string, so those values are
+// written with [time.Date] and [time.FixedZone] instead.
+func (dt DateTime) GoString() string {
+	name, offset := dt.Zone()
+	if offset%60 == 0 {
+		return fmt.Sprintf("rfc3339.MustParseDateTimeString(%q)", dt.ToString())
+	}
+	return fmt.Sprintf(
+		"rfc3339.NewFromTime(time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.FixedZone(%q, %d)))",
+		dt.Year(), dt.Month(), dt.Day(),
+		dt.Hour(), dt.Minute(), dt.Second(), dt.Nanosecond(),
+		name, offset,
+	)
+}
+
+// LogValue implements [slog.LogValuer] so that the [DateTime] is logged as
+// its RFC 3339 representation.
+func (dt DateTime) LogValue() slog.Value {
+	return slog.StringValue(dt.ToString())
+}
+
 // ToFullDate provides a convenient way to convert a [DateTime] to a [FullDate].
 func (dt DateTime) ToFullDate() FullDate {
 	return FullDate{
@@ -153,6 +237,157 @@ func (dt *DateTime) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalText implements the [encoding.TextMarshaler] interface. The result
+// is the RFC 3339 date-time string representation.
+func (dt DateTime) MarshalText() ([]byte, error) {
+	return []byte(dt.ToString()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. Empty
+// input results in a zero instance.
+func (dt *DateTime) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*dt = DateTime{}
+		return nil
+	}
+
+	parsed, err := NewDateTimeFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*dt = parsed
+
+	return nil
+}
+
+// MarshalTOML implements the TOML marshaler interface of
+// github.com/BurntSushi/toml. The result is a TOML offset date-time.
+func (dt DateTime) MarshalTOML() ([]byte, error) {
+	return []byte(dt.ToString()), nil
+}
+
+// UnmarshalTOML implements the TOML unmarshaler interface of decoders that
+// supply decoded values, e.g. github.com/BurntSushi/toml. TOML offset
+// date-times, and strings that are RFC 3339 date-time representations, are
+// accepted. TOML local date-times are rejected, because they do not have a
+// UTC offset.
+func (dt *DateTime) UnmarshalTOML(data any) error {
+	switch value := data.(type) {
+	case string:
+		return dt.UnmarshalText([]byte(value))
+	case time.Time:
+		switch value.Location().String() {
+		case tomlLocalDateTime, tomlLocalDate, tomlLocalTime:
+			return fmt.Errorf("TOML value must be an offset date-time, got: %s", tomlKind(value))
+		}
+		_, offset := value.Zone()
+		dt.Time = value.In(LocationFromOffset(offset))
+		return nil
+	default:
+		return fmt.Errorf("TOML value must be a string or offset date-time, got: %T", data)
+	}
+}
+
+// MarshalXML implements the [xml.Marshaler] interface. The element content
+// is the RFC 3339 date-time string representation, which is also a valid
+// XML Schema `xs:dateTime`. Zero instances are omitted.
+func (dt DateTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if dt.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(dt.ToString(), start)
+}
+
+// UnmarshalXML implements the [xml.Unmarshaler] interface. The element
+// content may be an RFC 3339 date-time, or an XML Schema `xs:dateTime` that
+// includes a timezone. The `24:00:00` end of day time is mapped to midnight
+// of the following day. Empty elements result in a zero instance.
+func (dt *DateTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var content string
+	if err := d.DecodeElement(&content, &start); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		*dt = DateTime{}
+		return nil
+	}
+
+	parsed, err := parseXSDateTime(content)
+	if err != nil {
+		return err
+	}
+	*dt = parsed
+
+	return nil
+}
+
+// MarshalXMLAttr implements the [xml.MarshalerAttr] interface. The attribute
+// value is the RFC 3339 date-time string representation. Zero instances are
+// omitted.
+func (dt DateTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if dt.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: dt.ToString()}, nil
+}
+
+// UnmarshalXMLAttr implements the [xml.UnmarshalerAttr] interface. It
+// accepts the same representations as [DateTime.UnmarshalXML].
+func (dt *DateTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	if strings.TrimSpace(attr.Value) == "" {
+		*dt = DateTime{}
+		return nil
+	}
+
+	parsed, err := parseXSDateTime(attr.Value)
+	if err != nil {
+		return err
+	}
+	*dt = parsed
+
+	return nil
+}
+
+// MarshalYAML implements the [yaml.Marshaler] interface. The result is
+// always a double quoted RFC 3339 date-time string, so that YAML parsers do not
+// resolve it with their own timestamp rules. Zero instances are serialized
+// as `null`.
+func (dt DateTime) MarshalYAML() (any, error) {
+	if dt.IsZero() {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Style: yaml.DoubleQuotedStyle,
+		Value: dt.ToString(),
+	}, nil
+}
+
+// UnmarshalYAML implements the [yaml.Unmarshaler] interface. Scalars are
+// parsed with [NewDateTimeFromString] regardless of whether they are quoted, or
+// how YAML would otherwise resolve them. Null and empty scalars result in a
+// zero instance.
+func (dt *DateTime) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: value must be a scalar", value.Line)
+	}
+
+	if value.ShortTag() == "!!null" || value.Value == "" {
+		*dt = DateTime{}
+		return nil
+	}
+
+	parsed, err := NewDateTimeFromString(value.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+	*dt = parsed
+
+	return nil
+}
+
 // Value implements the [driver.Valuer] interface to facilitate
 // storing [DateTime] values as strings in a database.
 func (dt DateTime) Value() (driver.Value, error) {
@@ -166,20 +401,77 @@ func (dt *DateTime) Scan(value any) error {
 		return nil
 	}
 
-	switch value.(type) {
-	case string, []byte:
-		str := value.(string)
-		if str == "" {
-			*dt = DateTime{}
-			return nil
-		}
-		parsed, err := NewDateTimeFromString(str)
-		if err != nil {
-			return err
-		}
-		*dt = parsed
-		return nil
+	var str string
+	switch value := value.(type) {
+	case string:
+		str = value
+	case []byte:
+		str = string(value)
 	default:
 		return fmt.Errorf("value must be a string, got: %T", value)
 	}
+
+	if str == "" {
+		*dt = DateTime{}
+		return nil
+	}
+	parsed, err := NewDateTimeFromString(str)
+	if err != nil {
+		return err
+	}
+	*dt = parsed
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface. The
+// encoding is versioned, and preserves the instant and the UTC offset of the
+// [DateTime]. Zone names are not preserved; decoded instances use the same
+// `UTC±hh:mm` zone names as [NewDateTimeFromString].
+func (dt DateTime) MarshalBinary() ([]byte, error) {
+	_, offset := dt.Zone()
+
+	data := make([]byte, dateTimeBinaryLen)
+	data[0] = dateTimeBinaryVersion
+	binary.BigEndian.PutUint64(data[1:9], uint64(dt.Unix()))
+	binary.BigEndian.PutUint32(data[9:13], uint32(dt.Nanosecond()))
+	binary.BigEndian.PutUint32(data[13:17], uint32(int32(offset)))
+
+	return data, nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface. It
+// accepts data generated by [DateTime.MarshalBinary].
+func (dt *DateTime) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("binary date-time data is empty")
+	}
+	if data[0] != dateTimeBinaryVersion {
+		return fmt.Errorf("unsupported binary date-time version: %d", data[0])
+	}
+	if len(data) != dateTimeBinaryLen {
+		return fmt.Errorf("invalid binary date-time length: %d", len(data))
+	}
+
+	sec := int64(binary.BigEndian.Uint64(data[1:9]))
+	nsec := int64(binary.BigEndian.Uint32(data[9:13]))
+	offset := int(int32(binary.BigEndian.Uint32(data[13:17])))
+	if nsec >= int64(time.Second) {
+		return fmt.Errorf("invalid binary date-time nanoseconds: %d", nsec)
+	}
+
+	dt.Time = time.Unix(sec, nsec).In(LocationFromOffset(offset))
+
+	return nil
+}
+
+// GobEncode implements the [gob.GobEncoder] interface. It uses the same
+// encoding as [DateTime.MarshalBinary].
+func (dt DateTime) GobEncode() ([]byte, error) {
+	return dt.MarshalBinary()
+}
+
+// GobDecode implements the [gob.GobDecoder] interface. It uses the same
+// encoding as [DateTime.UnmarshalBinary].
+func (dt *DateTime) GobDecode(data []byte) error {
+	return dt.UnmarshalBinary(data)
 }
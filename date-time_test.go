@@ -1,13 +1,17 @@
 package rfc3339
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestDateTime_IsDateTimeString(t *testing.T) {
@@ -103,6 +107,35 @@ func TestDateTime_NewFromString(t *testing.T) {
 		_, offset := dt.Zone()
 		assert.Equal(0, offset)
 	})
+
+	t.Run("parses string at lowercase z offset", func(t *testing.T) {
+		dt, err := NewDateTimeFromString("2023-03-24t22:30:00z")
+		require.NoError(t, err)
+		assert.Equal(t, "2023-03-24T22:30:00Z", dt.ToString())
+	})
+
+	t.Run("parses fractional seconds with leading zeros as decimal", func(t *testing.T) {
+		dt, err := NewDateTimeFromString("2023-01-01T00:00:00.0051Z")
+		require.NoError(t, err)
+		assert.Equal(t, 5100000, dt.Nanosecond())
+
+		dt, err = NewDateTimeFromString("2023-01-01T00:00:00.0089Z")
+		require.NoError(t, err)
+		assert.Equal(t, 8900000, dt.Nanosecond())
+	})
+
+	t.Run("parses fields with leading zeros as decimal", func(t *testing.T) {
+		inputs := []string{
+			"0089-08-09T08:09:08Z",
+			"0009-09-08T09:08:09-08:09",
+		}
+
+		for _, input := range inputs {
+			dt, err := NewDateTimeFromString(input)
+			require.NoError(t, err)
+			assert.Equal(t, input, dt.ToString())
+		}
+	})
 }
 
 func TestDateTime_ToString(t *testing.T) {
@@ -119,6 +152,51 @@ func TestDateTime_ToFullDate(t *testing.T) {
 	assert.Equal(t, "2024-04-06", dt.ToFullDate().ToString())
 }
 
+func TestLocationFromOffset(t *testing.T) {
+	t.Run("returns UTC for zero offset", func(t *testing.T) {
+		assert.Equal(t, time.UTC, LocationFromOffset(0))
+	})
+
+	t.Run("names fixed zones for the offset", func(t *testing.T) {
+		tests := []struct {
+			input    int
+			expected string
+		}{
+			{-14400, "UTC-04:00"},
+			{19800, "UTC+05:30"},
+			{-17762, "UTC-04:56:02"},
+		}
+
+		for _, test := range tests {
+			loc := LocationFromOffset(test.input)
+			name, offset := time.Date(2023, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(t, test.expected, name)
+			assert.Equal(t, test.input, offset)
+		}
+	})
+}
+
+func TestDateTime_IsZero(t *testing.T) {
+	t.Run("returns true for empty value", func(t *testing.T) {
+		assert.True(t, DateTime{}.IsZero())
+	})
+
+	t.Run("returns true for zero instant at UTC", func(t *testing.T) {
+		dt := MustParseDateTimeString("0001-01-01T00:00:00Z")
+		assert.True(t, dt.IsZero())
+	})
+
+	t.Run("returns false for midnight at another offset", func(t *testing.T) {
+		dt := MustParseDateTimeString("0001-01-01T00:00:00-04:00")
+		assert.False(t, dt.IsZero())
+	})
+
+	t.Run("returns false for current value", func(t *testing.T) {
+		dt := MustParseDateTimeString("2023-04-01T08:30:00-04:00")
+		assert.False(t, dt.IsZero())
+	})
+}
+
 func TestDateTime_MarshalJSON(t *testing.T) {
 	type j struct {
 		Created DateTime `json:"created"`
@@ -219,6 +297,159 @@ func TestDateTime_UnmarshalJSON(t *testing.T) {
 	})
 }
 
+func TestDateTime_XML(t *testing.T) {
+	type doc struct {
+		XMLName  xml.Name `xml:"doc"`
+		Issued   DateTime `xml:"issued,attr"`
+		Received DateTime `xml:"received"`
+	}
+
+	t.Run("marshals element and attribute", func(t *testing.T) {
+		input := doc{
+			XMLName:  xml.Name{Local: "doc"},
+			Issued:   MustParseDateTimeString("2023-04-01T08:30:00-04:00"),
+			Received: MustParseDateTimeString("2023-04-01T12:30:00.005Z"),
+		}
+		result, err := xml.Marshal(input)
+		require.NoError(t, err)
+
+		expected := `<doc issued="2023-04-01T08:30:00-04:00">` +
+			`<received>2023-04-01T12:30:00.005Z</received></doc>`
+		assert.Equal(t, expected, string(result))
+
+		var found doc
+		err = xml.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("omits zero values", func(t *testing.T) {
+		result, err := xml.Marshal(doc{})
+		require.NoError(t, err)
+		assert.Equal(t, `<doc></doc>`, string(result))
+	})
+
+	t.Run("unmarshals schema variants", func(t *testing.T) {
+		input := `<doc issued="2023-04-01T24:00:00-04:00">` +
+			"<received>\n  2023-04-01T12:30:00z\n</received></doc>"
+
+		var found doc
+		err := xml.Unmarshal([]byte(input), &found)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-02T00:00:00-04:00", found.Issued.ToString())
+		assert.Equal(t, "2023-04-01T12:30:00Z", found.Received.ToString())
+	})
+
+	t.Run("unmarshals empty values", func(t *testing.T) {
+		var found doc
+		err := xml.Unmarshal([]byte(`<doc issued=""><received/></doc>`), &found)
+		require.NoError(t, err)
+		assert.True(t, found.Issued.IsZero())
+		assert.True(t, found.Received.IsZero())
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		inputs := []string{
+			`<doc issued="2023-04-01T08:30:00"></doc>`,
+			`<doc><received>2023-04-01</received></doc>`,
+		}
+
+		for _, input := range inputs {
+			var found doc
+			err := xml.Unmarshal([]byte(input), &found)
+			assert.ErrorContains(t, err, "input is not a date-time string")
+		}
+	})
+}
+
+func TestDateTime_YAML(t *testing.T) {
+	type config struct {
+		Created DateTime `yaml:"created"`
+		Updated DateTime `yaml:"updated"`
+	}
+
+	t.Run("marshals quoted strings", func(t *testing.T) {
+		input := config{
+			Created: MustParseDateTimeString("2023-04-01T08:30:00-04:00"),
+		}
+		result, err := yaml.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			"created: \"2023-04-01T08:30:00-04:00\"\nupdated: null\n",
+			string(result),
+		)
+
+		var found config
+		err = yaml.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("unmarshals unquoted timestamps strictly", func(t *testing.T) {
+		input := "created: 2023-04-01T08:30:00.005-04:00\nupdated: ''\n"
+
+		var found config
+		err := yaml.Unmarshal([]byte(input), &found)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00.005-04:00", found.Created.ToString())
+		assert.True(t, found.Updated.IsZero())
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{
+				"created: 2023-04-01 08:30:00\n",
+				"line 1: input is not a date-time string: 2023-04-01 08:30:00",
+			},
+			{
+				"updated: 2023-04-01\n",
+				"line 1: input is not a date-time string: 2023-04-01",
+			},
+			{
+				"created: [2023-04-01T08:30:00Z]\n",
+				"line 1: value must be a scalar",
+			},
+		}
+
+		for _, test := range tests {
+			var found config
+			err := yaml.Unmarshal([]byte(test.input), &found)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
+
+func TestDateTime_Text(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := MustParseDateTimeString("2023-04-01T08:30:00.005-04:00")
+		data, err := input.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00.005-04:00", string(data))
+
+		var found DateTime
+		err = found.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("empty input is empty", func(t *testing.T) {
+		found := MustParseDateTimeString("2023-04-01T08:30:00.005-04:00")
+		err := found.UnmarshalText(nil)
+		require.NoError(t, err)
+		assert.Equal(t, DateTime{}, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		var found DateTime
+		err := found.UnmarshalText([]byte("2023-04-01"))
+		assert.ErrorContains(t, err, "input is not a date-time string")
+	})
+}
+
 func Test_DTValue(t *testing.T) {
 	dt, _ := NewDateTimeFromString("2023-09-27T13:15:00.000-04:00")
 	str, err := dt.Value()
@@ -260,6 +491,109 @@ func Test_DTScan(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "2023-09-27T13:15:00-04:00", dt.ToString())
 	})
+
+	t.Run("scans bytes", func(t *testing.T) {
+		dt := DateTime{}
+		err := dt.Scan([]byte("2023-09-27T13:15:00.000-04:00"))
+		assert.Nil(t, err)
+		assert.Equal(t, "2023-09-27T13:15:00-04:00", dt.ToString())
+	})
+}
+
+func TestDateTime_MarshalBinary(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		inputs := []string{
+			"2023-04-01T08:30:00-04:00",
+			"2023-04-01T08:30:00.123456789+05:30",
+			"2023-04-01T08:30:00Z",
+			"0001-01-01T00:00:00Z",
+			"9999-12-31T23:59:59.999999999-12:00",
+		}
+
+		for _, input := range inputs {
+			expected := MustParseDateTimeString(input)
+			data, err := expected.MarshalBinary()
+			require.NoError(t, err)
+			assert.Len(t, data, dateTimeBinaryLen)
+
+			var found DateTime
+			err = found.UnmarshalBinary(data)
+			require.NoError(t, err)
+			assert.Equal(t, expected, found)
+			assert.Equal(t, input, found.ToString())
+		}
+	})
+
+	t.Run("round trips empty value", func(t *testing.T) {
+		data, err := DateTime{}.MarshalBinary()
+		require.NoError(t, err)
+
+		var found DateTime
+		err = found.UnmarshalBinary(data)
+		require.NoError(t, err)
+		assert.Equal(t, DateTime{}, found)
+	})
+
+	t.Run("preserves offsets with seconds", func(t *testing.T) {
+		expected := NewFromTime(
+			time.Date(1883, 11, 18, 12, 3, 58, 0, time.FixedZone("LMT", -17762)),
+		)
+		data, err := expected.MarshalBinary()
+		require.NoError(t, err)
+
+		var found DateTime
+		err = found.UnmarshalBinary(data)
+		require.NoError(t, err)
+		assert.True(t, expected.Equal(found.Time))
+		name, offset := found.Zone()
+		assert.Equal(t, "UTC-04:56:02", name)
+		assert.Equal(t, -17762, offset)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		data, _ := MustParseDateTimeString("2023-04-01T08:30:00Z").MarshalBinary()
+		badVersion := append([]byte{2}, data[1:]...)
+		badNanos := append([]byte{}, data...)
+		copy(badNanos[9:13], []byte{0xff, 0xff, 0xff, 0xff})
+
+		tests := []struct {
+			input    []byte
+			expected string
+		}{
+			{nil, "binary date-time data is empty"},
+			{badVersion, "unsupported binary date-time version: 2"},
+			{data[:10], "invalid binary date-time length: 10"},
+			{badNanos, "invalid binary date-time nanoseconds: 4294967295"},
+		}
+
+		for _, test := range tests {
+			var found DateTime
+			err := found.UnmarshalBinary(test.input)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
+
+func TestDateTime_Gob(t *testing.T) {
+	type message struct {
+		Created DateTime
+		Updated DateTime
+	}
+
+	expected := message{
+		Created: MustParseDateTimeString("2023-04-01T08:30:00.005-04:00"),
+		Updated: MustParseDateTimeString("2023-04-02T10:00:00+09:00"),
+	}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(expected)
+	require.NoError(t, err)
+
+	var found message
+	err = gob.NewDecoder(&buf).Decode(&found)
+	require.NoError(t, err)
+	assert.Equal(t, expected, found)
+	assert.Equal(t, "2023-04-02T10:00:00+09:00", found.Updated.ToString())
 }
 
 func Benchmark_DTScan(b *testing.B) {
@@ -268,3 +602,90 @@ func Benchmark_DTScan(b *testing.B) {
 		dt.Scan("2023-10-12T09:00:00.000-04:00")
 	}
 }
+
+// dateTimeBenchmarks are the [DateTime] entry points covered by the
+// benchmarks and the allocation budgets.
+var dateTimeBenchmarks = []struct {
+	name string
+	fn   func()
+}{
+	{name: "IsDateTimeString", fn: func() {
+		IsDateTimeString("2023-10-12T09:00:00.000-04:00")
+	}},
+	{name: "NewDateTimeFromString/Z", fn: func() {
+		NewDateTimeFromString("2023-10-12T09:00:00Z")
+	}},
+	{name: "NewDateTimeFromString/offset", fn: func() {
+		NewDateTimeFromString("2023-10-12T09:00:00-04:00")
+	}},
+	{name: "NewDateTimeFromString/secfrac-Z", fn: func() {
+		NewDateTimeFromString("2023-10-12T09:00:00.123456789Z")
+	}},
+	{name: "NewDateTimeFromString/secfrac-offset", fn: func() {
+		NewDateTimeFromString("2023-10-12T09:00:00.123456789-04:00")
+	}},
+	{name: "ToString", fn: func() {
+		benchmarkDateTime.ToString()
+	}},
+	{name: "MarshalJSON", fn: func() {
+		benchmarkDateTime.MarshalJSON()
+	}},
+	{name: "UnmarshalJSON", fn: func() {
+		var dt DateTime
+		dt.UnmarshalJSON([]byte(`"2023-10-12T09:00:00.123-04:00"`))
+	}},
+	{name: "Value", fn: func() {
+		benchmarkDateTime.Value()
+	}},
+	{name: "Scan", fn: func() {
+		var dt DateTime
+		dt.Scan("2023-10-12T09:00:00.123-04:00")
+	}},
+}
+
+var benchmarkDateTime = MustParseDateTimeString("2023-10-12T09:00:00.123-04:00")
+
+func Benchmark_DateTime(b *testing.B) {
+	for _, bench := range dateTimeBenchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i += 1 {
+				bench.fn()
+			}
+		})
+	}
+}
+
+// dateTimeAllocBudgets are the maximum allocations per call of the
+// [DateTime] entry points. Lower a budget when an optimization lands, so
+// that a later regression fails the tests.
+// The budgets are the exact counts measured with Go 1.24. When a Go
+// release changes a count, measure it with the benchmarks and update the
+// budget in a change of its own.
+var dateTimeAllocBudgets = map[string]float64{
+	"IsDateTimeString":                     0,
+	"NewDateTimeFromString/Z":              20,
+	"NewDateTimeFromString/offset":         21,
+	"NewDateTimeFromString/secfrac-Z":      21,
+	"NewDateTimeFromString/secfrac-offset": 22,
+	"ToString":                             1,
+	"MarshalJSON":                          4,
+	"UnmarshalJSON":                        23,
+	"Value":                                1,
+	"Scan":                                 22,
+}
+
+func TestDateTime_AllocBudgets(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations differ under the race detector")
+	}
+
+	for _, bench := range dateTimeBenchmarks {
+		t.Run(bench.name, func(t *testing.T) {
+			budget, ok := dateTimeAllocBudgets[bench.name]
+			require.True(t, ok, "missing allocation budget")
+			allocs := testing.AllocsPerRun(100, bench.fn)
+			assert.LessOrEqual(t, allocs, budget)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package rfc3339
+
+import (
+	"strings"
+
+	"github.com/jsumners/go-reggie"
+)
+
+// durationParts are the productions of the `duration` ABNF in appendix A of
+// RFC 3339.
+var durationParts = []string{
+	`^P(`,
+	// dur-date = (dur-day / dur-month / dur-year) [dur-time]
+	`(\d+D|\d+M(\d+D)?|\d+Y(\d+M(\d+D)?)?)`,
+	`(T(\d+H(\d+M(\d+S)?)?|\d+M(\d+S)?|\d+S))?`,
+	"|",
+	// dur-time
+	`T(\d+H(\d+M(\d+S)?)?|\d+M(\d+S)?|\d+S)`,
+	"|",
+	// dur-week
+	`\d+W`,
+	`)$`,
+}
+
+var durationRegex = reggie.MustCompile(
+	strings.Join(durationParts, ""),
+)
+
+// IsDurationString verifies if an input string matches the format of an
+// ISO 8601 `duration` as defined in appendix A of RFC 3339, e.g. `P1Y2M3D`,
+// `PT1H30M`, or `P2W`.
+func IsDurationString(input string) bool {
+	return durationRegex.MatchString(input)
+}
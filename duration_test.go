@@ -0,0 +1,46 @@
+package rfc3339
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsDurationString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "P1Y", expected: true},
+		{input: "P1Y2M", expected: true},
+		{input: "P1Y2M3D", expected: true},
+		{input: "P1Y2M3DT4H5M6S", expected: true},
+		{input: "P2M10D", expected: true},
+		{input: "P3D", expected: true},
+		{input: "P3DT12H", expected: true},
+		{input: "PT1H", expected: true},
+		{input: "PT1H30M", expected: true},
+		{input: "PT90M", expected: true},
+		{input: "PT1H30M15S", expected: true},
+		{input: "PT15S", expected: true},
+		{input: "P2W", expected: true},
+		{input: "P", expected: false},
+		{input: "PT", expected: false},
+		{input: "P1YT", expected: false},
+		{input: "P1Y3D", expected: false},
+		{input: "PT1H15S", expected: false},
+		{input: "P1W2D", expected: false},
+		{input: "P1.5Y", expected: false},
+		{input: "PT0.5S", expected: false},
+		{input: "p1y", expected: false},
+		{input: "1Y", expected: false},
+		{input: "P1D1Y", expected: false},
+		{input: " P1D", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsDurationString(test.input))
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package rfc3339
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// Decode parses an RFC 3339 `date-time` string into the [DateTime]. It
+// satisfies the `Decoder` interface used by configuration libraries such as
+// github.com/kelseyhightower/envconfig.
+func (dt *DateTime) Decode(input string) error {
+	return dt.Set(input)
+}
+
+// EnvDecode parses an RFC 3339 `date-time` string into the [DateTime]. It
+// satisfies the `Decoder` interface used by github.com/sethvargo/go-envconfig.
+func (dt *DateTime) EnvDecode(input string) error {
+	return dt.Set(input)
+}
+
+// Decode parses an RFC 3339 `full-date` string into the [FullDate]. It
+// satisfies the `Decoder` interface used by configuration libraries such as
+// github.com/kelseyhightower/envconfig.
+func (fd *FullDate) Decode(input string) error {
+	return fd.Set(input)
+}
+
+// EnvDecode parses an RFC 3339 `full-date` string into the [FullDate]. It
+// satisfies the `Decoder` interface used by github.com/sethvargo/go-envconfig.
+func (fd *FullDate) EnvDecode(input string) error {
+	return fd.Set(input)
+}
+
+// EnvError reports an environment variable that could not be decoded into
+// its struct field.
+type EnvError struct {
+	Name  string
+	Field string
+	Err   error
+}
+
+func (e *EnvError) Error() string {
+	return fmt.Sprintf("environment variable %s (field %s): %s", e.Name, e.Field, e.Err)
+}
+
+func (e *EnvError) Unwrap() error {
+	return e.Err
+}
+
+// ErrEnvRequired indicates that a variable marked `required` is not set.
+var ErrEnvRequired = errors.New("required variable is not set")
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// envTag is the parsed form of an `rfc3339` struct tag's environment options.
+type envTag struct {
+	name         string
+	required     bool
+	defaultValue string
+	hasDefault   bool
+}
+
+// parseEnvTag parses the comma separated options of an `rfc3339` struct
+// tag. Options other than `env=NAME`, `required`, and `default=VALUE` are
+// ignored so that the tag can be shared with other features of this package.
+func parseEnvTag(tag string) envTag {
+	var parsed envTag
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		switch {
+		case strings.HasPrefix(option, "env="):
+			parsed.name = strings.TrimPrefix(option, "env=")
+		case strings.HasPrefix(option, "default="):
+			parsed.defaultValue = strings.TrimPrefix(option, "default=")
+			parsed.hasDefault = true
+		case option == "required":
+			parsed.required = true
+		}
+	}
+	return parsed
+}
+
+// LoadEnv fills the fields of the struct pointed to by `v` from environment
+// variables. Fields are selected with the `env=NAME` option of an `rfc3339`
+// struct tag, e.g.:
+//
+//	type Config struct {
+//		Since rfc3339.DateTime `rfc3339:"env=SINCE,required"`
+//		AsOf  rfc3339.FullDate `rfc3339:"env=AS_OF,default=2023-01-01"`
+//	}
+//
+// The `required` option results in an error wrapping [ErrEnvRequired] when
+// the variable is not set, and the `default=VALUE` option supplies a value
+// for an unset variable. A variable that is set to an empty string is
+// treated as not set. Fields without a tag are left untouched, except that
+// nested structs are filled recursively.
+//
+// Supported field types are [DateTime], [FullDate], [PartialTime], pointers
+// to them, and any type that implements [encoding.TextUnmarshaler].
+// Variables are read with `lookup`, which has the signature of
+// [os.LookupEnv]; a nil `lookup` results in [os.LookupEnv] being used.
+//
+// All failures are returned together, via [errors.Join], as [*EnvError]
+// values that name the offending variable.
+func LoadEnv(v any, lookup func(name string) (string, bool)) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("value must be a non-nil pointer to a struct, got: %T", v)
+	}
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+
+	return errors.Join(loadEnvStruct(rv.Elem(), "", lookup)...)
+}
+
+func loadEnvStruct(rv reflect.Value, path string, lookup func(string) (string, bool)) []error {
+	var errs []error
+	typ := rv.Type()
+	for i := 0; i < typ.NumField(); i += 1 {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fieldPath := sf.Name
+		if path != "" {
+			fieldPath = path + "." + sf.Name
+		}
+		fv := rv.Field(i)
+
+		tag := parseEnvTag(sf.Tag.Get("rfc3339"))
+		if tag.name == "" {
+			if fv.Kind() == reflect.Struct && !fv.Addr().Type().Implements(textUnmarshalerType) {
+				errs = append(errs, loadEnvStruct(fv, fieldPath, lookup)...)
+			}
+			continue
+		}
+
+		value, ok := lookup(tag.name)
+		if !ok || value == "" {
+			if tag.hasDefault {
+				value = tag.defaultValue
+			} else {
+				if tag.required {
+					errs = append(errs, &EnvError{Name: tag.name, Field: fieldPath, Err: ErrEnvRequired})
+				}
+				continue
+			}
+		}
+
+		if err := decodeEnvValue(fv, value); err != nil {
+			errs = append(errs, &EnvError{Name: tag.name, Field: fieldPath, Err: err})
+		}
+	}
+	return errs
+}
+
+// decodeEnvValue parses `value` into the field `fv`. Nil pointer fields
+// are only allocated when parsing succeeds.
+func decodeEnvValue(fv reflect.Value, value string) error {
+	if fv.Kind() == reflect.Pointer {
+		target := reflect.New(fv.Type().Elem())
+		if err := decodeEnvValue(target.Elem(), value); err != nil {
+			return err
+		}
+		fv.Set(target)
+		return nil
+	}
+
+	switch target := fv.Addr().Interface().(type) {
+	case *DateTime:
+		return target.Decode(value)
+	case *FullDate:
+		return target.Decode(value)
+	case *PartialTime:
+		parsed, err := NewPartialTimeFromString(value)
+		if err != nil {
+			return err
+		}
+		*target = parsed
+		return nil
+	case encoding.TextUnmarshaler:
+		return target.UnmarshalText([]byte(value))
+	default:
+		return fmt.Errorf("unsupported field type: %s", fv.Type())
+	}
+}
@@ -0,0 +1,143 @@
+package rfc3339
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mapLookup(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("DateTime", func(t *testing.T) {
+		var dt DateTime
+		require.NoError(t, dt.Decode("2023-04-04T12:30:00Z"))
+		assert.Equal(t, MustParseDateTimeString("2023-04-04T12:30:00Z"), dt)
+
+		dt = DateTime{}
+		require.NoError(t, dt.EnvDecode("2023-04-04T12:30:00+02:00"))
+		assert.Equal(t, MustParseDateTimeString("2023-04-04T12:30:00+02:00"), dt)
+
+		assert.ErrorContains(t, dt.Decode("2023-04-04"), "input is not a date-time string: 2023-04-04")
+		assert.ErrorContains(t, dt.EnvDecode(""), "input is not a date-time string: ")
+	})
+
+	t.Run("FullDate", func(t *testing.T) {
+		var fd FullDate
+		require.NoError(t, fd.Decode("2023-04-04"))
+		assert.Equal(t, MustParseDateString("2023-04-04"), fd)
+
+		fd = FullDate{}
+		require.NoError(t, fd.EnvDecode("2023-04-05"))
+		assert.Equal(t, MustParseDateString("2023-04-05"), fd)
+
+		assert.ErrorContains(t, fd.Decode("04/04/2023"), "`04/04/2023` is not a full-date string")
+		assert.ErrorContains(t, fd.EnvDecode("today"), "`today` is not a full-date string")
+	})
+}
+
+func TestLoadEnv(t *testing.T) {
+	type database struct {
+		Cutoff DateTime `rfc3339:"env=DB_CUTOFF"`
+	}
+	type config struct {
+		Since    DateTime    `rfc3339:"env=SINCE,required"`
+		AsOf     FullDate    `rfc3339:"env=AS_OF,default=2023-01-01"`
+		Open     PartialTime `rfc3339:"env=OPEN"`
+		Until    *DateTime   `rfc3339:"env=UNTIL"`
+		Address  netip.Addr  `rfc3339:"env=ADDRESS"`
+		Database database
+		Other    DateTime
+		Shared   DateTime `rfc3339:"datetime"`
+		internal DateTime `rfc3339:"env=INTERNAL"`
+	}
+
+	t.Run("fills tagged fields", func(t *testing.T) {
+		env := map[string]string{
+			"SINCE":     "2023-04-04T12:30:00-04:00",
+			"OPEN":      "09:00:00",
+			"UNTIL":     "2023-05-01T00:00:00Z",
+			"ADDRESS":   "127.0.0.1",
+			"DB_CUTOFF": "2023-03-01T00:00:00Z",
+			"INTERNAL":  "2023-03-01T00:00:00Z",
+		}
+
+		var found config
+		err := LoadEnv(&found, mapLookup(env))
+		require.NoError(t, err)
+		assert.Equal(t, MustParseDateTimeString("2023-04-04T12:30:00-04:00"), found.Since)
+		assert.Equal(t, MustParseDateString("2023-01-01"), found.AsOf)
+		assert.Equal(t, MustParsePartialTimeString("09:00:00"), found.Open)
+		require.NotNil(t, found.Until)
+		assert.Equal(t, MustParseDateTimeString("2023-05-01T00:00:00Z"), *found.Until)
+		assert.Equal(t, netip.MustParseAddr("127.0.0.1"), found.Address)
+		assert.Equal(t, MustParseDateTimeString("2023-03-01T00:00:00Z"), found.Database.Cutoff)
+		assert.True(t, found.Other.IsZero())
+		assert.True(t, found.Shared.IsZero())
+		assert.True(t, found.internal.IsZero())
+	})
+
+	t.Run("leaves unset fields untouched", func(t *testing.T) {
+		found := config{Open: MustParsePartialTimeString("10:00:00")}
+		err := LoadEnv(&found, mapLookup(map[string]string{
+			"SINCE": "2023-04-04T12:30:00Z",
+			"AS_OF": "",
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, MustParsePartialTimeString("10:00:00"), found.Open)
+		assert.Equal(t, MustParseDateString("2023-01-01"), found.AsOf)
+		assert.Nil(t, found.Until)
+	})
+
+	t.Run("names every offending variable", func(t *testing.T) {
+		env := map[string]string{
+			"AS_OF":     "2023-04-04T00:00:00Z",
+			"UNTIL":     "tomorrow",
+			"DB_CUTOFF": "2023-03-01",
+		}
+
+		var found config
+		err := LoadEnv(&found, mapLookup(env))
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrEnvRequired)
+		assert.ErrorContains(t, err, "environment variable SINCE (field Since): required variable is not set")
+		assert.ErrorContains(t, err, "environment variable AS_OF (field AsOf): `2023-04-04T00:00:00Z` is not a full-date string")
+		assert.ErrorContains(t, err, "environment variable UNTIL (field Until): input is not a date-time string: tomorrow")
+		assert.ErrorContains(t, err, "environment variable DB_CUTOFF (field Database.Cutoff): input is not a date-time string: 2023-03-01")
+		assert.Nil(t, found.Until)
+
+		var envErr *EnvError
+		require.True(t, errors.As(err, &envErr))
+		assert.Equal(t, "SINCE", envErr.Name)
+	})
+
+	t.Run("reports unsupported field types", func(t *testing.T) {
+		var found struct {
+			Count int `rfc3339:"env=COUNT"`
+		}
+		err := LoadEnv(&found, mapLookup(map[string]string{"COUNT": "1"}))
+		assert.ErrorContains(t, err, "environment variable COUNT (field Count): unsupported field type: int")
+	})
+
+	t.Run("uses os.LookupEnv by default", func(t *testing.T) {
+		t.Setenv("RFC3339_TEST_SINCE", "2023-04-04T12:30:00Z")
+		var found struct {
+			Since DateTime `rfc3339:"env=RFC3339_TEST_SINCE"`
+		}
+		require.NoError(t, LoadEnv(&found, nil))
+		assert.Equal(t, MustParseDateTimeString("2023-04-04T12:30:00Z"), found.Since)
+	})
+
+	t.Run("requires a pointer to a struct", func(t *testing.T) {
+		err := LoadEnv(config{}, nil)
+		assert.ErrorContains(t, err, "value must be a non-nil pointer to a struct, got: rfc3339.config")
+	})
+}
@@ -20,5 +20,5 @@ func Example_datetime() {
 
 	fmt.Printf("%v\n", myJson)
 	// Output:
-	// {2023-04-04 12:30:00 -0400 UTC-04:00}
+	// {2023-04-04T12:30:00-04:00}
 }
@@ -0,0 +1,190 @@
+package rfc3339
+
+import (
+	"flag"
+	"time"
+)
+
+// flagClock provides the current time for the relative-time shortcuts
+// accepted by the flag values.
+var flagClock = SystemClock
+
+// Set implements [flag.Value]. It parses an RFC 3339 `date-time` string
+// into the [DateTime]. Relative-time shortcuts are not accepted; see
+// [NewDateTimeFlag] for a flag value that accepts them.
+func (dt *DateTime) Set(input string) error {
+	parsed, err := NewDateTimeFromString(input)
+	if err != nil {
+		return err
+	}
+	dt.Time = parsed.Time
+	return nil
+}
+
+// Type implements the `pflag.Value` interface of [github.com/spf13/pflag].
+// It names the type of value in help output.
+func (dt *DateTime) Type() string {
+	return "date-time"
+}
+
+// Set implements [flag.Value]. It parses an RFC 3339 `full-date` string
+// into the [FullDate]. Relative-time shortcuts are not accepted; see
+// [NewFullDateFlag] for a flag value that accepts them.
+func (fd *FullDate) Set(input string) error {
+	parsed, err := NewFullDateFromString(input)
+	if err != nil {
+		return err
+	}
+	fd.Time = parsed.Time
+	return nil
+}
+
+// Type implements the `pflag.Value` interface of [github.com/spf13/pflag].
+// It names the type of value in help output.
+func (fd *FullDate) Type() string {
+	return "full-date"
+}
+
+// DateTimeFlag is a command-line flag value that stores into a [DateTime].
+// In addition to RFC 3339 `date-time` strings, it accepts the shortcuts
+// `now`, `today` (midnight at the start of the current day in the local
+// time zone), and `yesterday` (midnight at the start of the previous day).
+// It implements both [flag.Value] and the `pflag.Value` interface of
+// [github.com/spf13/pflag].
+type DateTimeFlag struct {
+	dt *DateTime
+}
+
+// NewDateTimeFlag creates a [DateTimeFlag] that stores into `p`, after
+// setting `p` to the default `value`.
+func NewDateTimeFlag(p *DateTime, value DateTime) *DateTimeFlag {
+	*p = value
+	return &DateTimeFlag{dt: p}
+}
+
+// Set parses the input, which is either a shortcut or an RFC 3339
+// `date-time` string.
+func (f *DateTimeFlag) Set(input string) error {
+	current := flagClock.Now().Round(0)
+	switch input {
+	case "now":
+		f.dt.Time = current
+	case "today":
+		f.dt.Time = startOfDay(current, 0)
+	case "yesterday":
+		f.dt.Time = startOfDay(current, -1)
+	default:
+		return f.dt.Set(input)
+	}
+	return nil
+}
+
+// String returns the RFC 3339 representation of the current value.
+func (f *DateTimeFlag) String() string {
+	if f == nil || f.dt == nil || f.dt.IsZero() {
+		return ""
+	}
+	return f.dt.ToString()
+}
+
+// Type names the type of value in help output.
+func (f *DateTimeFlag) Type() string {
+	return f.dt.Type()
+}
+
+// Get implements [flag.Getter]. It returns the [DateTime].
+func (f *DateTimeFlag) Get() any {
+	return *f.dt
+}
+
+// FullDateFlag is a command-line flag value that stores into a [FullDate].
+// In addition to RFC 3339 `full-date` strings, it accepts the shortcuts
+// `today` and `now` (both the current day in the local time zone), and
+// `yesterday`. It implements both [flag.Value] and the `pflag.Value`
+// interface of [github.com/spf13/pflag].
+type FullDateFlag struct {
+	fd *FullDate
+}
+
+// NewFullDateFlag creates a [FullDateFlag] that stores into `p`, after
+// setting `p` to the default `value`.
+func NewFullDateFlag(p *FullDate, value FullDate) *FullDateFlag {
+	*p = value
+	return &FullDateFlag{fd: p}
+}
+
+// Set parses the input, which is either a shortcut or an RFC 3339
+// `full-date` string.
+func (f *FullDateFlag) Set(input string) error {
+	current := flagClock.Now().Round(0)
+	switch input {
+	case "now", "today":
+		*f.fd = NewFullDate(current.Date())
+	case "yesterday":
+		year, month, day := current.Date()
+		*f.fd = NewFullDate(year, month, day-1)
+	default:
+		return f.fd.Set(input)
+	}
+	return nil
+}
+
+// String returns the RFC 3339 representation of the current value.
+func (f *FullDateFlag) String() string {
+	if f == nil || f.fd == nil || f.fd.IsZero() {
+		return ""
+	}
+	return f.fd.ToString()
+}
+
+// Type names the type of value in help output.
+func (f *FullDateFlag) Type() string {
+	return f.fd.Type()
+}
+
+// Get implements [flag.Getter]. It returns the [FullDate].
+func (f *FullDateFlag) Get() any {
+	return *f.fd
+}
+
+// FlagSet extends a [flag.FlagSet] with methods that define [DateTimeFlag]
+// and [FullDateFlag] flags, e.g.
+// `rfc3339.FlagSet{FlagSet: fs}.DateTimeVar(&since, "since", rfc3339.DateTime{}, "usage")`.
+type FlagSet struct {
+	*flag.FlagSet
+}
+
+// DateTimeVar defines a [DateTimeFlag] with the specified name, default
+// value, and usage string. The argument `p` points to a [DateTime] variable
+// in which to store the value of the flag.
+func (fs FlagSet) DateTimeVar(p *DateTime, name string, value DateTime, usage string) {
+	fs.Var(NewDateTimeFlag(p, value), name, usage)
+}
+
+// FullDateVar defines a [FullDateFlag] with the specified name, default
+// value, and usage string. The argument `p` points to a [FullDate] variable
+// in which to store the value of the flag.
+func (fs FlagSet) FullDateVar(p *FullDate, name string, value FullDate, usage string) {
+	fs.Var(NewFullDateFlag(p, value), name, usage)
+}
+
+// DateTimeVar defines a [DateTimeFlag] with the specified name, default
+// value, and usage string on [flag.CommandLine]. The argument `p` points to
+// a [DateTime] variable in which to store the value of the flag.
+func DateTimeVar(p *DateTime, name string, value DateTime, usage string) {
+	FlagSet{FlagSet: flag.CommandLine}.DateTimeVar(p, name, value, usage)
+}
+
+// FullDateVar defines a [FullDateFlag] with the specified name, default
+// value, and usage string on [flag.CommandLine]. The argument `p` points to
+// a [FullDate] variable in which to store the value of the flag.
+func FullDateVar(p *FullDate, name string, value FullDate, usage string) {
+	FlagSet{FlagSet: flag.CommandLine}.FullDateVar(p, name, value, usage)
+}
+
+// startOfDay returns midnight at the start of the day `days` away from the
+// day of `t`, in the location of `t`.
+func startOfDay(t time.Time, days int) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day+days, 0, 0, 0, 0, t.Location())
+}
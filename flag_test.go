@@ -0,0 +1,202 @@
+package rfc3339
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pflagValue mirrors the `pflag.Value` interface of
+// github.com/spf13/pflag.
+type pflagValue interface {
+	String() string
+	Set(string) error
+	Type() string
+}
+
+var (
+	_ flag.Value  = (*DateTime)(nil)
+	_ flag.Value  = (*FullDate)(nil)
+	_ pflagValue  = (*DateTime)(nil)
+	_ pflagValue  = (*FullDate)(nil)
+	_ flag.Getter = (*DateTimeFlag)(nil)
+	_ flag.Getter = (*FullDateFlag)(nil)
+	_ pflagValue  = (*DateTimeFlag)(nil)
+	_ pflagValue  = (*FullDateFlag)(nil)
+)
+
+func withNow(t *testing.T, current time.Time) {
+	t.Helper()
+	original := flagClock
+	flagClock = FixedClock(current)
+	t.Cleanup(func() { flagClock = original })
+}
+
+func newFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return fs
+}
+
+// withCommandLine replaces [flag.CommandLine] for the duration of the test.
+func withCommandLine(t *testing.T) *flag.FlagSet {
+	previous := flag.CommandLine
+	t.Cleanup(func() { flag.CommandLine = previous })
+	flag.CommandLine = newFlagSet()
+	return flag.CommandLine
+}
+
+func TestDateTime_Set(t *testing.T) {
+	t.Run("parses strictly", func(t *testing.T) {
+		var dt DateTime
+		require.NoError(t, dt.Set("2023-04-04T12:30:00-04:00"))
+		assert.Equal(t, "2023-04-04T12:30:00-04:00", dt.ToString())
+		assert.Equal(t, "date-time", dt.Type())
+
+		err := dt.Set("now")
+		assert.ErrorContains(t, err, "input is not a date-time string: now")
+		assert.Equal(t, "2023-04-04T12:30:00-04:00", dt.ToString())
+	})
+
+	t.Run("works with flag.Var", func(t *testing.T) {
+		var dt DateTime
+		fs := newFlagSet()
+		fs.Var(&dt, "since", "usage")
+		require.NoError(t, fs.Parse([]string{"--since", "2023-04-04T12:30:00Z"}))
+		assert.Equal(t, MustParseDateTimeString("2023-04-04T12:30:00Z"), dt)
+
+		err := fs.Parse([]string{"--since", "yesterday"})
+		assert.ErrorContains(t, err, `invalid value "yesterday" for flag -since`)
+	})
+}
+
+func TestFullDate_Set(t *testing.T) {
+	var fd FullDate
+	require.NoError(t, fd.Set("2023-04-04"))
+	assert.Equal(t, MustParseDateString("2023-04-04"), fd)
+	assert.Equal(t, "full-date", fd.Type())
+
+	err := fd.Set("today")
+	assert.ErrorContains(t, err, "`today` is not a full-date string")
+}
+
+func TestDateTimeVar(t *testing.T) {
+	location := time.FixedZone("EDT", -4*60*60)
+	withNow(t, time.Date(2023, time.April, 4, 12, 30, 15, 5, location))
+	defaultValue := MustParseDateTimeString("2023-01-01T00:00:00Z")
+
+	t.Run("uses the default", func(t *testing.T) {
+		var dt DateTime
+		fs := newFlagSet()
+		FlagSet{FlagSet: fs}.DateTimeVar(&dt, "since", defaultValue, "usage")
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, defaultValue, dt)
+		assert.Equal(t, "2023-01-01T00:00:00Z", fs.Lookup("since").DefValue)
+	})
+
+	tests := []struct {
+		input    string
+		expected time.Time
+	}{
+		{input: "now", expected: time.Date(2023, time.April, 4, 12, 30, 15, 5, location)},
+		{input: "today", expected: time.Date(2023, time.April, 4, 0, 0, 0, 0, location)},
+		{input: "yesterday", expected: time.Date(2023, time.April, 3, 0, 0, 0, 0, location)},
+		{input: "2023-02-01T08:00:00+01:00", expected: MustParseDateTimeString("2023-02-01T08:00:00+01:00").Time},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var dt DateTime
+			fs := newFlagSet()
+			FlagSet{FlagSet: fs}.DateTimeVar(&dt, "since", defaultValue, "usage")
+			require.NoError(t, fs.Parse([]string{"-since", test.input}))
+			assert.Equal(t, test.expected, dt.Time)
+			assert.Equal(t, dt, fs.Lookup("since").Value.(flag.Getter).Get())
+		})
+	}
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		var dt DateTime
+		fs := newFlagSet()
+		FlagSet{FlagSet: fs}.DateTimeVar(&dt, "since", DateTime{}, "usage")
+		err := fs.Parse([]string{"-since", "tomorrow"})
+		assert.ErrorContains(t, err, "input is not a date-time string: tomorrow")
+		assert.Equal(t, "", fs.Lookup("since").DefValue)
+	})
+
+	t.Run("defines flags on the command line", func(t *testing.T) {
+		var dt DateTime
+		fs := withCommandLine(t)
+		DateTimeVar(&dt, "since", defaultValue, "usage")
+		require.NoError(t, fs.Parse([]string{"-since", "today"}))
+		assert.Equal(t, time.Date(2023, time.April, 4, 0, 0, 0, 0, location), dt.Time)
+	})
+
+	t.Run("reports its type", func(t *testing.T) {
+		var dt DateTime
+		assert.Equal(t, "date-time", NewDateTimeFlag(&dt, DateTime{}).Type())
+	})
+}
+
+func TestDateTimeFlag_Now(t *testing.T) {
+	current := time.Now()
+	withNow(t, current)
+
+	var dt DateTime
+	require.NoError(t, NewDateTimeFlag(&dt, DateTime{}).Set("now"))
+	// The monotonic clock reading is stripped, so that == behaves as it does
+	// for a parsed value.
+	assert.True(t, dt.Time == current.Round(0))
+}
+
+func TestFullDateVar(t *testing.T) {
+	withNow(t, time.Date(2023, time.March, 1, 1, 30, 0, 0, time.UTC))
+	defaultValue := MustParseDateString("2023-01-01")
+
+	t.Run("uses the default", func(t *testing.T) {
+		var fd FullDate
+		fs := newFlagSet()
+		FlagSet{FlagSet: fs}.FullDateVar(&fd, "as-of", defaultValue, "usage")
+		require.NoError(t, fs.Parse(nil))
+		assert.Equal(t, defaultValue, fd)
+		assert.Equal(t, "2023-01-01", fs.Lookup("as-of").DefValue)
+	})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "now", expected: "2023-03-01"},
+		{input: "today", expected: "2023-03-01"},
+		{input: "yesterday", expected: "2023-02-28"},
+		{input: "2023-02-01", expected: "2023-02-01"},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			var fd FullDate
+			fs := newFlagSet()
+			FlagSet{FlagSet: fs}.FullDateVar(&fd, "as-of", defaultValue, "usage")
+			require.NoError(t, fs.Parse([]string{"-as-of", test.input}))
+			assert.Equal(t, MustParseDateString(test.expected), fd)
+		})
+	}
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		var fd FullDate
+		fs := newFlagSet()
+		FlagSet{FlagSet: fs}.FullDateVar(&fd, "as-of", defaultValue, "usage")
+		err := fs.Parse([]string{"-as-of", "2023-04-04T00:00:00Z"})
+		assert.ErrorContains(t, err, "`2023-04-04T00:00:00Z` is not a full-date string")
+	})
+
+	t.Run("defines flags on the command line", func(t *testing.T) {
+		var fd FullDate
+		fs := withCommandLine(t)
+		FullDateVar(&fd, "as-of", defaultValue, "usage")
+		require.NoError(t, fs.Parse([]string{"-as-of", "yesterday"}))
+		assert.Equal(t, MustParseDateString("2023-02-28"), fd)
+	})
+}
@@ -0,0 +1,16 @@
+package rfc3339
+
+import "strings"
+
+// dateTimeLayout builds a [time.Time.Format] layout for an RFC 3339
+// `date-time` with exactly `precision` fractional second digits. The
+// precision is limited to 9 digits, i.e. nanoseconds.
+func dateTimeLayout(precision int) string {
+	if precision <= 0 {
+		return "2006-01-02T15:04:05Z07:00"
+	}
+	if precision > 9 {
+		precision = 9
+	}
+	return "2006-01-02T15:04:05." + strings.Repeat("0", precision) + "Z07:00"
+}
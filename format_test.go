@@ -0,0 +1,112 @@
+package rfc3339
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTime_Format(t *testing.T) {
+	dt := MustParseDateTimeString("2023-04-04T12:30:00.123456-04:00")
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{format: "%v", expected: "2023-04-04T12:30:00.123456-04:00"},
+		{format: "%s", expected: "2023-04-04T12:30:00.123456-04:00"},
+		{format: "%q", expected: `"2023-04-04T12:30:00.123456-04:00"`},
+		{format: "%35v", expected: "   2023-04-04T12:30:00.123456-04:00"},
+		{format: "%-35v|", expected: "2023-04-04T12:30:00.123456-04:00   |"},
+		{format: "%#v", expected: `rfc3339.MustParseDateTimeString("2023-04-04T12:30:00.123456-04:00")`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.expected, fmt.Sprintf(test.format, dt))
+		})
+	}
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, dt.ToString(), dt.String())
+		assert.Equal(t, "2023-04-04T12:30:00Z", MustParseDateTimeString("2023-04-04T12:30:00Z").String())
+	})
+
+	t.Run("GoString keeps offset seconds", func(t *testing.T) {
+		lmt := NewFromTime(time.Date(1883, time.November, 18, 12, 3, 58, 5, time.FixedZone("LMT", -17762)))
+		expected := `rfc3339.NewFromTime(time.Date(1883, time.November, 18, 12, 3, 58, 5, time.FixedZone("LMT", -17762)))`
+		assert.Equal(t, expected, fmt.Sprintf("%#v", lmt))
+	})
+
+	t.Run("formats struct fields", func(t *testing.T) {
+		input := struct {
+			Created DateTime
+			Date    FullDate
+		}{
+			Created: MustParseDateTimeString("2023-04-04T12:30:00-04:00"),
+			Date:    MustParseDateString("2023-04-04"),
+		}
+		assert.Equal(t, "{2023-04-04T12:30:00-04:00 2023-04-04}", fmt.Sprintf("%v", input))
+		assert.Equal(t, "{Created:2023-04-04T12:30:00-04:00 Date:2023-04-04}", fmt.Sprintf("%+v", input))
+	})
+
+	t.Run("time.Time layouts remain available", func(t *testing.T) {
+		assert.Equal(t, "2023-04-04 12:30", dt.Format("2006-01-02 15:04"))
+	})
+}
+
+func TestDateTime_ToStringPrecision(t *testing.T) {
+	dt := MustParseDateTimeString("2023-04-04T12:30:00.123456-04:00")
+
+	tests := []struct {
+		digits   int
+		expected string
+	}{
+		{digits: -1, expected: "2023-04-04T12:30:00-04:00"},
+		{digits: 0, expected: "2023-04-04T12:30:00-04:00"},
+		{digits: 3, expected: "2023-04-04T12:30:00.123-04:00"},
+		{digits: 9, expected: "2023-04-04T12:30:00.123456000-04:00"},
+		{digits: 12, expected: "2023-04-04T12:30:00.123456000-04:00"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, dt.ToStringPrecision(test.digits))
+	}
+
+	t.Run("pads whole seconds", func(t *testing.T) {
+		found := MustParseDateTimeString("2023-04-04T12:30:00Z").ToStringPrecision(3)
+		assert.Equal(t, "2023-04-04T12:30:00.000Z", found)
+	})
+}
+
+func TestFullDate_Format(t *testing.T) {
+	fd := MustParseDateString("2023-04-04")
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{format: "%v", expected: "2023-04-04"},
+		{format: "%s", expected: "2023-04-04"},
+		{format: "%q", expected: `"2023-04-04"`},
+		{format: "%12v", expected: "  2023-04-04"},
+		{format: "%-12v|", expected: "2023-04-04  |"},
+		{format: "%#v", expected: `rfc3339.MustParseDateString("2023-04-04")`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			assert.Equal(t, test.expected, fmt.Sprintf(test.format, fd))
+		})
+	}
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "2023-04-04", fd.String())
+	})
+
+	t.Run("time.Time layouts remain available", func(t *testing.T) {
+		assert.Equal(t, "Apr 4, 2023", fd.Format("Jan 2, 2006"))
+	})
+}
@@ -2,11 +2,35 @@ package rfc3339
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
+	"encoding/xml"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/jsumners/go-reggie"
+	"gopkg.in/yaml.v3"
+)
+
+// fullDateBinaryVersion identifies the layout written by
+// [FullDate.MarshalBinary]. It must be incremented whenever the layout
+// changes so that previously encoded data can still be decoded.
+const fullDateBinaryVersion byte = 1
+
+// fullDateBinaryLen is the length of a version 1 binary encoded [FullDate]:
+// 1 byte version, 2 bytes year, 1 byte month, and 1 byte day.
+const fullDateBinaryLen = 5
+
+// secondsPerDay is the length of a day in Unix time, which does not count
+// leap seconds.
+const secondsPerDay = 24 * 60 * 60
+
+// The epoch day numbers, i.e. days since 1970-01-01, of 0001-01-01 and
+// 9999-12-31.
+const (
+	minEpochDay = -719162
+	maxEpochDay = 2932896
 )
 
 var fullDateRegex = reggie.MustCompile(
@@ -43,13 +67,43 @@ func NewFullDateFromString(input string) (FullDate, error) {
 	month := fullDateRegex.SubmatchWithName("month")
 	day := fullDateRegex.SubmatchWithName("day")
 
-	d := time.Date(
-		toInt(year), time.Month(toInt(month)), toInt(day),
-		0, 0, 0, 0,
-		time.FixedZone("UTC", 0),
-	)
+	return NewFullDate(toInt(year), time.Month(toInt(month)), toInt(day)), nil
+}
 
-	return FullDate{Time: d}, nil
+// NewFullDate creates a new [FullDate] instance for the given date. As with
+// [time.Date], out of range values are normalized, e.g. April 31 becomes
+// May 1.
+func NewFullDate(year int, month time.Month, day int) FullDate {
+	return FullDate{
+		Time: time.Date(year, month, day, 0, 0, 0, 0, time.FixedZone("UTC", 0)),
+	}
+}
+
+// NewFullDateFromEpochDays creates a new [FullDate] instance from the number
+// of days since 1970-01-01. An error is returned if the date is outside the
+// range 0001-01-01 through 9999-12-31.
+func NewFullDateFromEpochDays(days int64) (FullDate, error) {
+	if days < minEpochDay || days > maxEpochDay {
+		return FullDate{}, fmt.Errorf("epoch day out of range for full-date: %d", days)
+	}
+	return NewFullDate(time.Unix(days*secondsPerDay, 0).UTC().Date()), nil
+}
+
+// EpochDays returns the number of days since 1970-01-01 of the date.
+func (fd FullDate) EpochDays() int64 {
+	year, month, day := fd.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+}
+
+// IsZero reports whether the [FullDate] represents the zero date,
+// 0001-01-01. Only the date parts are considered; the time parts and the
+// location are ignored. This keeps the result consistent regardless of how
+// the instance was created, e.g. via [DateTime.ToFullDate] with a non-UTC
+// location. Zero instances serialize to JSON `null`, and are omitted by
+// the `omitzero` JSON struct tag option of Go 1.24 and later.
+func (fd FullDate) IsZero() bool {
+	year, month, day := fd.Date()
+	return year == 1 && month == time.January && day == 1
 }
 
 // ToString serializes the [FullDate] instance to an RFC 3339 full-date
@@ -58,6 +112,23 @@ func (fd FullDate) ToString() string {
 	return fmt.Sprintf("%04d-%02d-%02d", fd.Year(), fd.Month(), fd.Day())
 }
 
+// String implements [fmt.Stringer]. It is equivalent to [FullDate.ToString].
+func (fd FullDate) String() string {
+	return fd.ToString()
+}
+
+// GoString implements [fmt.GoStringer] so that `%#v` writes a Go expression
+// that recreates the value.
+func (fd FullDate) GoString() string {
+	return fmt.Sprintf("rfc3339.MustParseDateString(%q)", fd.ToString())
+}
+
+// LogValue implements [slog.LogValuer] so that the [FullDate] is logged as
+// its RFC 3339 representation.
+func (fd FullDate) LogValue() slog.Value {
+	return slog.StringValue(fd.ToString())
+}
+
 // ToDateTime is a convenient way to convert a [FullDate] to a [DateTime].
 // Note: the [DateTime] will have its time portion set to 00:00:00 at UTC.
 func (fd FullDate) ToDateTime() DateTime {
@@ -90,6 +161,153 @@ func (fd *FullDate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalText implements the [encoding.TextMarshaler] interface. The result
+// is the RFC 3339 full-date string representation.
+func (fd FullDate) MarshalText() ([]byte, error) {
+	return []byte(fd.ToString()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. Empty
+// input results in a zero instance.
+func (fd *FullDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*fd = FullDate{}
+		return nil
+	}
+
+	parsed, err := NewFullDateFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*fd = parsed
+
+	return nil
+}
+
+// MarshalTOML implements the TOML marshaler interface of
+// github.com/BurntSushi/toml. The result is a TOML local date.
+func (fd FullDate) MarshalTOML() ([]byte, error) {
+	return []byte(fd.ToString()), nil
+}
+
+// UnmarshalTOML implements the TOML unmarshaler interface of decoders that
+// supply decoded values, e.g. github.com/BurntSushi/toml. TOML local dates,
+// and strings that are RFC 3339 full-date representations, are accepted.
+func (fd *FullDate) UnmarshalTOML(data any) error {
+	switch value := data.(type) {
+	case string:
+		return fd.UnmarshalText([]byte(value))
+	case time.Time:
+		if value.Location().String() != tomlLocalDate {
+			return fmt.Errorf("TOML value must be a local date, got: %s", tomlKind(value))
+		}
+		*fd = NewFullDate(value.Date())
+		return nil
+	default:
+		return fmt.Errorf("TOML value must be a string or local date, got: %T", data)
+	}
+}
+
+// MarshalXML implements the [xml.Marshaler] interface. The element content
+// is the RFC 3339 full-date string representation, which is also a valid
+// XML Schema `xs:date`. Zero instances are omitted.
+func (fd FullDate) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if fd.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(fd.ToString(), start)
+}
+
+// UnmarshalXML implements the [xml.Unmarshaler] interface. The element
+// content may be an RFC 3339 full-date, or an XML Schema `xs:date` with an
+// optional timezone. The timezone is discarded. Empty elements result in a
+// zero instance.
+func (fd *FullDate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var content string
+	if err := d.DecodeElement(&content, &start); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		*fd = FullDate{}
+		return nil
+	}
+
+	parsed, err := parseXSDate(content)
+	if err != nil {
+		return err
+	}
+	*fd = parsed
+
+	return nil
+}
+
+// MarshalXMLAttr implements the [xml.MarshalerAttr] interface. The attribute
+// value is the RFC 3339 full-date string representation. Zero instances are
+// omitted.
+func (fd FullDate) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if fd.IsZero() {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: fd.ToString()}, nil
+}
+
+// UnmarshalXMLAttr implements the [xml.UnmarshalerAttr] interface. It
+// accepts the same representations as [FullDate.UnmarshalXML].
+func (fd *FullDate) UnmarshalXMLAttr(attr xml.Attr) error {
+	if strings.TrimSpace(attr.Value) == "" {
+		*fd = FullDate{}
+		return nil
+	}
+
+	parsed, err := parseXSDate(attr.Value)
+	if err != nil {
+		return err
+	}
+	*fd = parsed
+
+	return nil
+}
+
+// MarshalYAML implements the [yaml.Marshaler] interface. The result is
+// always a double quoted RFC 3339 full-date string, so that YAML parsers do not
+// resolve it with their own timestamp rules. Zero instances are serialized
+// as `null`.
+func (fd FullDate) MarshalYAML() (any, error) {
+	if fd.IsZero() {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Style: yaml.DoubleQuotedStyle,
+		Value: fd.ToString(),
+	}, nil
+}
+
+// UnmarshalYAML implements the [yaml.Unmarshaler] interface. Scalars are
+// parsed with [NewFullDateFromString] regardless of whether they are quoted, or
+// how YAML would otherwise resolve them. Null and empty scalars result in a
+// zero instance.
+func (fd *FullDate) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: value must be a scalar", value.Line)
+	}
+
+	if value.ShortTag() == "!!null" || value.Value == "" {
+		*fd = FullDate{}
+		return nil
+	}
+
+	parsed, err := NewFullDateFromString(value.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+	*fd = parsed
+
+	return nil
+}
+
 // Value implements the [driver.Valuer] interface to facilitate
 // storing [FullDate] values as strings in a database.
 func (fd FullDate) Value() (driver.Value, error) {
@@ -103,22 +321,81 @@ func (fd *FullDate) Scan(value any) error {
 		return nil
 	}
 
-	switch value.(type) {
-	case string, []byte:
-		str := value.(string)
-		if str == "" {
-			*fd = FullDate{}
-			return nil
-		}
+	var str string
+	switch value := value.(type) {
+	case string:
+		str = value
+	case []byte:
+		str = string(value)
+	default:
+		return fmt.Errorf("value must be a string, got: %T", value)
+	}
 
-		parsed, err := NewFullDateFromString(str)
-		if err != nil {
-			return err
-		}
-		*fd = parsed
+	if str == "" {
+		*fd = FullDate{}
 		return nil
+	}
+	parsed, err := NewFullDateFromString(str)
+	if err != nil {
+		return err
+	}
+	*fd = parsed
+	return nil
+}
 
-	default:
-		return fmt.Errorf("value must be a string, got: %T", value)
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface. The
+// encoding is versioned, and only includes the date parts of the [FullDate].
+func (fd FullDate) MarshalBinary() ([]byte, error) {
+	year, month, day := fd.Date()
+	if year < 0 || year > 9999 {
+		return nil, fmt.Errorf("year out of range for full-date: %d", year)
 	}
+
+	data := make([]byte, fullDateBinaryLen)
+	data[0] = fullDateBinaryVersion
+	binary.BigEndian.PutUint16(data[1:3], uint16(year))
+	data[3] = byte(month)
+	data[4] = byte(day)
+
+	return data, nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface. It
+// accepts data generated by [FullDate.MarshalBinary]. Note that the time parts
+// will be set to 00:00:00.000 at the UTC (+00:00) offset.
+func (fd *FullDate) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("binary full-date data is empty")
+	}
+	if data[0] != fullDateBinaryVersion {
+		return fmt.Errorf("unsupported binary full-date version: %d", data[0])
+	}
+	if len(data) != fullDateBinaryLen {
+		return fmt.Errorf("invalid binary full-date length: %d", len(data))
+	}
+
+	year := int(binary.BigEndian.Uint16(data[1:3]))
+	month := int(data[3])
+	day := int(data[4])
+	if year > 9999 || month < 1 || month > 12 || day < 1 || day > 31 {
+		return fmt.Errorf(
+			"invalid binary full-date: %04d-%02d-%02d", year, month, day,
+		)
+	}
+
+	*fd = NewFullDate(year, time.Month(month), day)
+
+	return nil
+}
+
+// GobEncode implements the [gob.GobEncoder] interface. It uses the same
+// encoding as [FullDate.MarshalBinary].
+func (fd FullDate) GobEncode() ([]byte, error) {
+	return fd.MarshalBinary()
+}
+
+// GobDecode implements the [gob.GobDecoder] interface. It uses the same
+// encoding as [FullDate.UnmarshalBinary].
+func (fd *FullDate) GobDecode(data []byte) error {
+	return fd.UnmarshalBinary(data)
 }
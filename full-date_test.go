@@ -1,11 +1,16 @@
 package rfc3339
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestFullDate_IsFullDateString(t *testing.T) {
@@ -56,6 +61,58 @@ func TestFullDate_NewFromString(t *testing.T) {
 		)
 		assert.Equal(t, expected, fd.Time)
 	})
+
+	t.Run("parses fields with leading zeros as decimal", func(t *testing.T) {
+		inputs := []string{"0009-09-09", "0089-08-08", "0019-01-09"}
+
+		for _, input := range inputs {
+			fd, err := NewFullDateFromString(input)
+			require.NoError(t, err)
+			assert.Equal(t, input, fd.ToString())
+		}
+	})
+}
+
+func TestFullDate_NewFullDate(t *testing.T) {
+	t.Run("matches the parsed date", func(t *testing.T) {
+		found := NewFullDate(2023, time.April, 3)
+		assert.Equal(t, MustParseDateString("2023-04-03"), found)
+	})
+
+	t.Run("normalizes out of range values", func(t *testing.T) {
+		found := NewFullDate(2023, time.April, 31)
+		assert.Equal(t, "2023-05-01", found.ToString())
+	})
+}
+
+func TestFullDate_EpochDays(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"1970-01-01", 0},
+		{"2023-04-01", 19448},
+		{"1940-10-09", -10676},
+		{"0001-01-01", -719162},
+		{"9999-12-31", 2932896},
+	}
+
+	for _, test := range tests {
+		days := MustParseDateString(test.input).EpochDays()
+		assert.Equal(t, test.expected, days)
+
+		found, err := NewFullDateFromEpochDays(days)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseDateString(test.input), found)
+	}
+
+	t.Run("returns error for out of range values", func(t *testing.T) {
+		_, err := NewFullDateFromEpochDays(2932897)
+		assert.EqualError(t, err, "epoch day out of range for full-date: 2932897")
+
+		_, err = NewFullDateFromEpochDays(-719163)
+		assert.EqualError(t, err, "epoch day out of range for full-date: -719163")
+	})
 }
 
 func TestFullDate_ToString(t *testing.T) {
@@ -78,6 +135,27 @@ func TestFullDate_ToDateTime(t *testing.T) {
 	assert.Equal(t, "2024-04-06T00:00:00Z", fd.ToDateTime().ToString())
 }
 
+func TestFullDate_IsZero(t *testing.T) {
+	t.Run("returns true for empty value", func(t *testing.T) {
+		assert.True(t, FullDate{}.IsZero())
+	})
+
+	t.Run("returns true for parsed zero date", func(t *testing.T) {
+		fd := MustParseDateString("0001-01-01")
+		assert.True(t, fd.IsZero())
+	})
+
+	t.Run("returns true for zero date at another offset", func(t *testing.T) {
+		dt := MustParseDateTimeString("0001-01-01T00:00:00-04:00")
+		assert.True(t, dt.ToFullDate().IsZero())
+	})
+
+	t.Run("returns false for current value", func(t *testing.T) {
+		fd := MustParseDateString("2023-04-01")
+		assert.False(t, fd.IsZero())
+	})
+}
+
 func TestFullDate_MarshalJSON(t *testing.T) {
 	type j struct {
 		Created FullDate `json:"created"`
@@ -150,6 +228,143 @@ func TestFullDate_UnmarshalJSON(t *testing.T) {
 	})
 }
 
+func TestFullDate_XML(t *testing.T) {
+	type doc struct {
+		XMLName   xml.Name `xml:"doc"`
+		Issued    FullDate `xml:"issued,attr"`
+		Effective FullDate `xml:"effective"`
+	}
+
+	t.Run("marshals element and attribute", func(t *testing.T) {
+		input := doc{
+			XMLName:   xml.Name{Local: "doc"},
+			Issued:    MustParseDateString("2023-04-01"),
+			Effective: MustParseDateString("2023-05-01"),
+		}
+		result, err := xml.Marshal(input)
+		require.NoError(t, err)
+
+		expected := `<doc issued="2023-04-01"><effective>2023-05-01</effective></doc>`
+		assert.Equal(t, expected, string(result))
+
+		var found doc
+		err = xml.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("omits zero values", func(t *testing.T) {
+		result, err := xml.Marshal(doc{})
+		require.NoError(t, err)
+		assert.Equal(t, `<doc></doc>`, string(result))
+	})
+
+	t.Run("unmarshals schema variants", func(t *testing.T) {
+		input := `<doc issued="2023-04-01Z"><effective>2023-05-01-05:00</effective></doc>`
+
+		var found doc
+		err := xml.Unmarshal([]byte(input), &found)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseDateString("2023-04-01"), found.Issued)
+		assert.Equal(t, MustParseDateString("2023-05-01"), found.Effective)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		inputs := []string{
+			`<doc issued="2023/04/01"></doc>`,
+			`<doc><effective>2023-05-01T00:00:00Z</effective></doc>`,
+		}
+
+		for _, input := range inputs {
+			var found doc
+			err := xml.Unmarshal([]byte(input), &found)
+			assert.ErrorContains(t, err, "is not a full-date string")
+		}
+	})
+}
+
+func TestFullDate_YAML(t *testing.T) {
+	type config struct {
+		Effective FullDate `yaml:"effective"`
+		Expires   FullDate `yaml:"expires"`
+	}
+
+	t.Run("marshals quoted strings", func(t *testing.T) {
+		input := config{Effective: MustParseDateString("2023-04-01")}
+		result, err := yaml.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, "effective: \"2023-04-01\"\nexpires: null\n", string(result))
+
+		var found config
+		err = yaml.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("unmarshals unquoted dates strictly", func(t *testing.T) {
+		input := "effective: 2023-04-01\nexpires: ~\n"
+
+		var found config
+		err := yaml.Unmarshal([]byte(input), &found)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseDateString("2023-04-01"), found.Effective)
+		assert.True(t, found.Expires.IsZero())
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{
+				"effective: 2023-4-1\n",
+				"line 1: `2023-4-1` is not a full-date string",
+			},
+			{
+				"\nexpires: 2023-04-01T00:00:00Z\n",
+				"line 2: `2023-04-01T00:00:00Z` is not a full-date string",
+			},
+			{
+				"effective: {year: 2023}\n",
+				"line 1: value must be a scalar",
+			},
+		}
+
+		for _, test := range tests {
+			var found config
+			err := yaml.Unmarshal([]byte(test.input), &found)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
+
+func TestFullDate_Text(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := MustParseDateString("2023-04-01")
+		data, err := input.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01", string(data))
+
+		var found FullDate
+		err = found.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("empty input is empty", func(t *testing.T) {
+		found := MustParseDateString("2023-04-01")
+		err := found.UnmarshalText(nil)
+		require.NoError(t, err)
+		assert.Equal(t, FullDate{}, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		var found FullDate
+		err := found.UnmarshalText([]byte("2023-04-01T08:30:00Z"))
+		assert.ErrorContains(t, err, "is not a full-date string")
+	})
+}
+
 func Test_FDValue(t *testing.T) {
 	fd, _ := NewFullDateFromString("2023-09-28")
 	str, err := fd.Value()
@@ -191,6 +406,83 @@ func Test_FDScan(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "2023-09-28", fd.ToString())
 	})
+
+	t.Run("scans bytes", func(t *testing.T) {
+		fd := FullDate{}
+		err := fd.Scan([]byte("2023-09-28"))
+		assert.Nil(t, err)
+		assert.Equal(t, "2023-09-28", fd.ToString())
+	})
+}
+
+func TestFullDate_MarshalBinary(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		inputs := []string{"2023-04-01", "0001-01-01", "9999-12-31"}
+
+		for _, input := range inputs {
+			expected := MustParseDateString(input)
+			data, err := expected.MarshalBinary()
+			require.NoError(t, err)
+			assert.Len(t, data, fullDateBinaryLen)
+
+			var found FullDate
+			err = found.UnmarshalBinary(data)
+			require.NoError(t, err)
+			assert.Equal(t, expected, found)
+		}
+	})
+
+	t.Run("encodes only the date parts", func(t *testing.T) {
+		dt := MustParseDateTimeString("2023-04-01T23:30:00-04:00")
+		data, err := dt.ToFullDate().MarshalBinary()
+		require.NoError(t, err)
+
+		var found FullDate
+		err = found.UnmarshalBinary(data)
+		require.NoError(t, err)
+		assert.Equal(t, MustParseDateString("2023-04-01"), found)
+	})
+
+	t.Run("returns error for out of range year", func(t *testing.T) {
+		fd := FullDate{Time: time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)}
+		_, err := fd.MarshalBinary()
+		assert.EqualError(t, err, "year out of range for full-date: 10000")
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    []byte
+			expected string
+		}{
+			{nil, "binary full-date data is empty"},
+			{[]byte{2, 0x07, 0xe7, 4, 1}, "unsupported binary full-date version: 2"},
+			{[]byte{1, 0x07, 0xe7}, "invalid binary full-date length: 3"},
+			{[]byte{1, 0x07, 0xe7, 13, 1}, "invalid binary full-date: 2023-13-01"},
+		}
+
+		for _, test := range tests {
+			var found FullDate
+			err := found.UnmarshalBinary(test.input)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
+
+func TestFullDate_Gob(t *testing.T) {
+	type message struct {
+		Effective FullDate
+	}
+
+	expected := message{Effective: MustParseDateString("2023-04-01")}
+
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(expected)
+	require.NoError(t, err)
+
+	var found message
+	err = gob.NewDecoder(&buf).Decode(&found)
+	require.NoError(t, err)
+	assert.Equal(t, expected, found)
 }
 
 func Benchmark_FDScan(b *testing.B) {
@@ -199,3 +491,76 @@ func Benchmark_FDScan(b *testing.B) {
 		fd.Scan("2023-10-12")
 	}
 }
+
+// fullDateBenchmarks are the [FullDate] entry points covered by the
+// benchmarks and the allocation budgets.
+var fullDateBenchmarks = []struct {
+	name string
+	fn   func()
+}{
+	{name: "IsFullDateString", fn: func() {
+		IsFullDateString("2023-10-12")
+	}},
+	{name: "NewFullDateFromString", fn: func() {
+		NewFullDateFromString("2023-10-12")
+	}},
+	{name: "ToString", fn: func() {
+		benchmarkFullDate.ToString()
+	}},
+	{name: "MarshalJSON", fn: func() {
+		benchmarkFullDate.MarshalJSON()
+	}},
+	{name: "UnmarshalJSON", fn: func() {
+		var fd FullDate
+		fd.UnmarshalJSON([]byte(`"2023-10-12"`))
+	}},
+	{name: "Value", fn: func() {
+		benchmarkFullDate.Value()
+	}},
+	{name: "Scan", fn: func() {
+		var fd FullDate
+		fd.Scan("2023-10-12")
+	}},
+}
+
+var benchmarkFullDate = MustParseDateString("2023-10-12")
+
+func Benchmark_FullDate(b *testing.B) {
+	for _, bench := range fullDateBenchmarks {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i += 1 {
+				bench.fn()
+			}
+		})
+	}
+}
+
+// fullDateAllocBudgets are the maximum allocations per call of the
+// [FullDate] entry points. Lower a budget when an optimization lands, so
+// that a later regression fails the tests.
+// Like [dateTimeAllocBudgets], these are exact counts.
+var fullDateAllocBudgets = map[string]float64{
+	"IsFullDateString":      0,
+	"NewFullDateFromString": 8,
+	"ToString":              2,
+	"MarshalJSON":           5,
+	"UnmarshalJSON":         9,
+	"Value":                 2,
+	"Scan":                  8,
+}
+
+func TestFullDate_AllocBudgets(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations differ under the race detector")
+	}
+
+	for _, bench := range fullDateBenchmarks {
+		t.Run(bench.name, func(t *testing.T) {
+			budget, ok := fullDateAllocBudgets[bench.name]
+			require.True(t, ok, "missing allocation budget")
+			allocs := testing.AllocsPerRun(100, bench.fn)
+			assert.LessOrEqual(t, allocs, budget)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package rfc3339
+
+import (
+	"fmt"
+
+	"github.com/jsumners/go-reggie"
+)
+
+var fullTimeRegex = reggie.MustCompile(
+	`^(?P<hour>\d{2}):(?P<minute>\d{2}):(?P<second>\d{2})(?P<secfrac>\.\d+)?` +
+		`((?P<offsetTime>[+-]\d{2}:\d{2})|(?P<offsetZ>[zZ]))$`,
+)
+
+// IsFullTimeString verifies if an input string matches the format of an
+// RFC 3339 `full-time` representation, i.e. a `partial-time` followed by a
+// `time-offset`.
+func IsFullTimeString(input string) bool {
+	return fullTimeRegex.MatchString(input)
+}
+
+// MustParseFullTimeString wraps [NewFullTimeFromString] such that if an error
+// happens it generates a panic.
+func MustParseFullTimeString(input string) FullTime {
+	ft, err := NewFullTimeFromString(input)
+	if err != nil {
+		panic(err)
+	}
+	return ft
+}
+
+// NewFullTimeFromString creates a new [FullTime] instance from an RFC 3339
+// `full-time` string representation. The offset is interpreted as by
+// [NewDateTimeFromString].
+func NewFullTimeFromString(input string) (FullTime, error) {
+	if !IsFullTimeString(input) {
+		return FullTime{}, fmt.Errorf("`%s` is not a full-time string", input)
+	}
+
+	dt, err := NewDateTimeFromString("0000-01-01T" + input)
+	if err != nil {
+		return FullTime{}, fmt.Errorf("`%s` is not a full-time string", input)
+	}
+
+	return FullTime{Time: dt.Time}, nil
+}
+
+// ToString serializes the [FullTime] instance to an RFC 3339 full-time
+// string representation.
+func (ft FullTime) ToString() string {
+	return ft.Time.Format("15:04:05.999999999Z07:00")
+}
+
+// String implements [fmt.Stringer]. It is equivalent to [FullTime.ToString].
+func (ft FullTime) String() string {
+	return ft.ToString()
+}
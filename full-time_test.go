@@ -0,0 +1,60 @@
+package rfc3339
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsFullTimeString(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "12:30:00Z", expected: true},
+		{input: "12:30:00z", expected: true},
+		{input: "12:30:00.123456-04:00", expected: true},
+		{input: "23:59:60+00:00", expected: true},
+		{input: "12:30:00", expected: false},
+		{input: "12:30Z", expected: false},
+		{input: "12:30:00+0400", expected: false},
+		{input: "2023-04-04T12:30:00Z", expected: false},
+		{input: "12:30:00.Z", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsFullTimeString(test.input))
+		})
+	}
+}
+
+func Test_NewFullTimeFromString(t *testing.T) {
+	t.Run("parses with an offset", func(t *testing.T) {
+		found, err := NewFullTimeFromString("12:30:00.5-04:00")
+		assert.NoError(t, err)
+		assert.Equal(t, "12:30:00.5-04:00", found.ToString())
+		assert.Equal(t, "12:30:00.5-04:00", found.String())
+
+		year, month, day := found.Date()
+		assert.Equal(t, []int{0, 1, 1}, []int{year, int(month), day})
+		_, offset := found.Zone()
+		assert.Equal(t, -4*60*60, offset)
+	})
+
+	t.Run("parses Z", func(t *testing.T) {
+		found := MustParseFullTimeString("23:59:59z")
+		assert.Equal(t, "23:59:59Z", found.ToString())
+		assert.Equal(t, time.UTC, found.Location())
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		_, err := NewFullTimeFromString("12:30:00")
+		assert.EqualError(t, err, "`12:30:00` is not a full-time string")
+
+		assert.Panics(t, func() {
+			MustParseFullTimeString("2023-04-04T12:30:00Z")
+		})
+	})
+}
@@ -0,0 +1,190 @@
+package rfc3339
+
+// The fuzz targets below run their seed corpus, which includes every input of
+// testdata/conformance, as part of `go test`. To fuzz one of them, run e.g.:
+//
+//	go test -run '^$' -fuzz '^FuzzNewDateTimeFromString$' -fuzztime 1m .
+
+import (
+	"testing"
+	"time"
+)
+
+// addConformanceSeeds adds every input of a conformance corpus file to the
+// seed corpus of a fuzz target.
+func addConformanceSeeds(f *testing.F, name string) {
+	f.Helper()
+	for _, test := range loadConformance(f, name) {
+		f.Add(test.Input)
+	}
+}
+
+// representable reports whether [time.Time.Format] can write the time as an
+// RFC 3339 string: the year must have four digits and the offset must be
+// below 100 hours. Lenient inputs can be normalized into times that are not.
+func representable(t time.Time) bool {
+	_, offset := t.Zone()
+	return t.Year() >= 0 && t.Year() <= 9999 && offset > -100*3600 && offset < 100*3600
+}
+
+func FuzzNewDateTimeFromString(f *testing.F) {
+	addConformanceSeeds(f, "date-time")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		dt, err := NewDateTimeFromString(input)
+		if IsDateTimeString(input) != (err == nil) {
+			t.Fatalf("IsDateTimeString(%q) disagrees with the parse error: %v", input, err)
+		}
+		if err != nil {
+			return
+		}
+
+		if parsed, err := time.Parse(time.RFC3339Nano, input); err == nil && !sameInstant(dt.Time, parsed) {
+			t.Fatalf("%q parsed as %s, but time.Parse results in %s", input, dt.ToString(), parsed.Format(time.RFC3339Nano))
+		}
+
+		if !representable(dt.Time) {
+			return
+		}
+		serialized := dt.ToString()
+		reparsed, err := NewDateTimeFromString(serialized)
+		if err != nil {
+			t.Fatalf("%q serialized as %q, which does not parse: %v", input, serialized, err)
+		}
+		if !sameInstant(dt.Time, reparsed.Time) {
+			t.Fatalf("%q serialized as %q, which parses as %s", input, serialized, reparsed.ToString())
+		}
+	})
+}
+
+func FuzzNewFullDateFromString(f *testing.F) {
+	addConformanceSeeds(f, "full-date")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		fd, err := NewFullDateFromString(input)
+		if IsFullDateString(input) != (err == nil) {
+			t.Fatalf("IsFullDateString(%q) disagrees with the parse error: %v", input, err)
+		}
+		if err != nil {
+			return
+		}
+
+		if parsed, err := time.Parse(time.DateOnly, input); err == nil && fd.ToString() != parsed.Format(time.DateOnly) {
+			t.Fatalf("%q parsed as %s, but time.Parse results in %s", input, fd.ToString(), parsed.Format(time.DateOnly))
+		}
+
+		if !representable(fd.Time) {
+			return
+		}
+		serialized := fd.ToString()
+		reparsed, err := NewFullDateFromString(serialized)
+		if err != nil {
+			t.Fatalf("%q serialized as %q, which does not parse: %v", input, serialized, err)
+		}
+		if !sameInstant(fd.Time, reparsed.Time) {
+			t.Fatalf("%q serialized as %q, which parses as %s", input, serialized, reparsed.ToString())
+		}
+	})
+}
+
+func FuzzDateTime_JSON(f *testing.F) {
+	addConformanceSeeds(f, "date-time")
+	f.Add("null")
+	f.Add(`"2023-04-04T12:30:00Z"`)
+	f.Add(`""`)
+
+	f.Fuzz(func(t *testing.T, input string) {
+		var dt DateTime
+		if err := dt.UnmarshalJSON([]byte(input)); err != nil || dt.IsZero() || !representable(dt.Time) {
+			return
+		}
+
+		data, err := dt.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%q does not marshal: %v", input, err)
+		}
+		var found DateTime
+		if err := found.UnmarshalJSON(data); err != nil {
+			t.Fatalf("%q marshaled as %s, which does not unmarshal: %v", input, data, err)
+		}
+		if !sameInstant(dt.Time, found.Time) {
+			t.Fatalf("%q marshaled as %s, which unmarshals as %s", input, data, found.ToString())
+		}
+	})
+}
+
+func FuzzFullDate_JSON(f *testing.F) {
+	addConformanceSeeds(f, "full-date")
+	f.Add("null")
+	f.Add(`"2023-04-04"`)
+	f.Add(`""`)
+
+	f.Fuzz(func(t *testing.T, input string) {
+		var fd FullDate
+		if err := fd.UnmarshalJSON([]byte(input)); err != nil || fd.IsZero() || !representable(fd.Time) {
+			return
+		}
+
+		data, err := fd.MarshalJSON()
+		if err != nil {
+			t.Fatalf("%q does not marshal: %v", input, err)
+		}
+		var found FullDate
+		if err := found.UnmarshalJSON(data); err != nil {
+			t.Fatalf("%q marshaled as %s, which does not unmarshal: %v", input, data, err)
+		}
+		if !sameInstant(fd.Time, found.Time) {
+			t.Fatalf("%q marshaled as %s, which unmarshals as %s", input, data, found.ToString())
+		}
+	})
+}
+
+func FuzzDateTime_Scan(f *testing.F) {
+	addConformanceSeeds(f, "date-time")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		var fromString, fromBytes DateTime
+		stringErr := fromString.Scan(input)
+		bytesErr := fromBytes.Scan([]byte(input))
+		if (stringErr == nil) != (bytesErr == nil) || !sameInstant(fromString.Time, fromBytes.Time) {
+			t.Fatalf("%q scans differently from a string and from bytes: %v, %v", input, stringErr, bytesErr)
+		}
+		if stringErr != nil || fromString.IsZero() || !representable(fromString.Time) {
+			return
+		}
+
+		value, err := fromString.Value()
+		if err != nil {
+			t.Fatalf("%q does not produce a value: %v", input, err)
+		}
+		var found DateTime
+		if err := found.Scan(value); err != nil || !sameInstant(fromString.Time, found.Time) {
+			t.Fatalf("%q has the value %v, which scans as %s: %v", input, value, found.ToString(), err)
+		}
+	})
+}
+
+func FuzzFullDate_Scan(f *testing.F) {
+	addConformanceSeeds(f, "full-date")
+
+	f.Fuzz(func(t *testing.T, input string) {
+		var fromString, fromBytes FullDate
+		stringErr := fromString.Scan(input)
+		bytesErr := fromBytes.Scan([]byte(input))
+		if (stringErr == nil) != (bytesErr == nil) || !sameInstant(fromString.Time, fromBytes.Time) {
+			t.Fatalf("%q scans differently from a string and from bytes: %v, %v", input, stringErr, bytesErr)
+		}
+		if stringErr != nil || fromString.IsZero() || !representable(fromString.Time) {
+			return
+		}
+
+		value, err := fromString.Value()
+		if err != nil {
+			t.Fatalf("%q does not produce a value: %v", input, err)
+		}
+		var found FullDate
+		if err := found.Scan(value); err != nil || !sameInstant(fromString.Time, found.Time) {
+			t.Fatalf("%q has the value %v, which scans as %s: %v", input, value, found.ToString(), err)
+		}
+	})
+}
@@ -1,16 +1,19 @@
 module github.com/jsumners/go-rfc3339
 
-go 1.21
+go 1.21.0
 
 require (
 	github.com/jsumners/go-reggie v1.0.0-rc.2
 	github.com/spf13/cast v1.6.0
-	github.com/stretchr/testify v1.8.2
+	github.com/stretchr/testify v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rogpeppe/go-internal v1.12.0 // indirect
 	github.com/samber/mo v1.11.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 )
@@ -0,0 +1,4 @@
+// Package tomltest tests the TOML support of the core package against the
+// common TOML libraries. It is a separate module so that the core module does
+// not require those libraries.
+package tomltest
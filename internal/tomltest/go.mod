@@ -0,0 +1,19 @@
+module github.com/jsumners/go-rfc3339/internal/tomltest
+
+go 1.21.0
+
+require (
+	github.com/BurntSushi/toml v1.6.0
+	github.com/jsumners/go-rfc3339 v1.3.0
+	github.com/pelletier/go-toml/v2 v2.4.3
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jsumners/go-reggie v1.0.0-rc.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/samber/mo v1.11.0 // indirect
+	github.com/spf13/cast v1.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,159 @@
+package tomltest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/jsumners/go-rfc3339"
+	pelletier "github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tomlSchedule struct {
+	Published rfc3339.DateTime      `toml:"published"`
+	Effective rfc3339.FullDate      `toml:"effective"`
+	Opens     rfc3339.PartialTime   `toml:"opens"`
+	Starts    rfc3339.LocalDateTime `toml:"starts"`
+}
+
+func TestTOML_Decode(t *testing.T) {
+	t.Run("decodes native values", func(t *testing.T) {
+		doc := `
+published = 2023-04-01T08:30:00.005-04:00
+effective = 2023-05-01
+opens = 07:32:00.5
+starts = 2023-05-01T07:32:00.5
+`
+		var found tomlSchedule
+		_, err := toml.Decode(doc, &found)
+		require.NoError(t, err)
+
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.005-04:00"), found.Published)
+		assert.Equal(t, rfc3339.MustParseDateString("2023-05-01"), found.Effective)
+		assert.Equal(t, rfc3339.MustParsePartialTimeString("07:32:00.5"), found.Opens)
+		assert.Equal(t, rfc3339.MustParseLocalDateTimeString("2023-05-01T07:32:00.5"), found.Starts)
+	})
+
+	t.Run("decodes Z offsets to UTC", func(t *testing.T) {
+		var found tomlSchedule
+		_, err := toml.Decode(`published = 2023-04-01T12:30:00Z`, &found)
+		require.NoError(t, err)
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-01T12:30:00Z"), found.Published)
+	})
+
+	t.Run("decodes strings", func(t *testing.T) {
+		doc := `
+published = "2023-04-01T08:30:00-04:00"
+effective = "2023-05-01"
+opens = "07:32:00"
+starts = "2023-05-01T07:32:00"
+`
+		var found tomlSchedule
+		_, err := toml.Decode(doc, &found)
+		require.NoError(t, err)
+
+		assert.Equal(t, "2023-04-01T08:30:00-04:00", found.Published.ToString())
+		assert.Equal(t, "2023-05-01", found.Effective.ToString())
+		assert.Equal(t, "07:32:00", found.Opens.ToString())
+		assert.Equal(t, "2023-05-01T07:32:00", found.Starts.ToString())
+	})
+
+	t.Run("returns error for mismatched types", func(t *testing.T) {
+		tests := []struct {
+			doc      string
+			expected string
+		}{
+			{
+				`published = 2023-04-01T08:30:00`,
+				"TOML value must be an offset date-time, got: local date-time",
+			},
+			{
+				`published = 2023-04-01`,
+				"TOML value must be an offset date-time, got: local date",
+			},
+			{
+				`effective = 2023-05-01T00:00:00Z`,
+				"TOML value must be a local date, got: offset date-time",
+			},
+			{
+				`opens = 1979-05-27T07:32:00`,
+				"TOML value must be a local time, got: local date-time",
+			},
+			{
+				`effective = 20230501`,
+				"TOML value must be a string or local date, got: int64",
+			},
+			{
+				`starts = 2023-05-01T07:32:00Z`,
+				"TOML value must be a local date-time, got: offset date-time",
+			},
+			{
+				`starts = 2023-05-01`,
+				"TOML value must be a local date-time, got: local date",
+			},
+			{
+				`published = "2023-04-01 08:30:00-04:00"`,
+				"input is not a date-time string: 2023-04-01 08:30:00-04:00",
+			},
+		}
+
+		for _, test := range tests {
+			var found tomlSchedule
+			_, err := toml.Decode(test.doc, &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
+
+func TestTOML_Encode(t *testing.T) {
+	input := tomlSchedule{
+		Published: rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.005-04:00"),
+		Effective: rfc3339.MustParseDateString("2023-05-01"),
+		Opens:     rfc3339.MustParsePartialTimeString("07:32:00"),
+		Starts:    rfc3339.MustParseLocalDateTimeString("2023-05-01T07:32:00"),
+	}
+
+	var buf bytes.Buffer
+	err := toml.NewEncoder(&buf).Encode(input)
+	require.NoError(t, err)
+
+	expected := "published = 2023-04-01T08:30:00.005-04:00\n" +
+		"effective = 2023-05-01\n" +
+		"opens = 07:32:00\n" +
+		"starts = 2023-05-01T07:32:00\n"
+	assert.Equal(t, expected, buf.String())
+
+	var found tomlSchedule
+	_, err = toml.Decode(buf.String(), &found)
+	require.NoError(t, err)
+	assert.Equal(t, input, found)
+}
+
+// github.com/pelletier/go-toml/v2 only hands strings to custom types, so the
+// values round trip as TOML strings rather than native values.
+func TestTOML_Pelletier(t *testing.T) {
+	input := tomlSchedule{
+		Published: rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.005-04:00"),
+		Effective: rfc3339.MustParseDateString("2023-05-01"),
+		Opens:     rfc3339.MustParsePartialTimeString("07:32:00"),
+		Starts:    rfc3339.MustParseLocalDateTimeString("2023-05-01T07:32:00"),
+	}
+
+	t.Run("encodes strings", func(t *testing.T) {
+		data, err := pelletier.Marshal(input)
+		require.NoError(t, err)
+
+		expected := "published = '2023-04-01T08:30:00.005-04:00'\n" +
+			"effective = '2023-05-01'\n" +
+			"opens = '07:32:00'\n" +
+			"starts = '2023-05-01T07:32:00'\n"
+		assert.Equal(t, expected, string(data))
+
+		var found tomlSchedule
+		err = pelletier.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+}
@@ -0,0 +1,173 @@
+package rfc3339
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jsumners/go-reggie"
+)
+
+var localDateTimeRegex = reggie.MustCompile(
+	`^(?P<year>\d{4})-(?P<month>\d{2})-(?P<day>\d{2})[tT]` +
+		`(?P<hour>\d{2}):(?P<minute>\d{2}):(?P<second>\d{2})(?P<secfrac>\.\d+)?$`,
+)
+
+// IsLocalDateTimeString verifies if an input string matches the format of
+// a `full-date "T" partial-time` representation, i.e. an RFC 3339
+// `date-time` without a UTC offset.
+func IsLocalDateTimeString(input string) bool {
+	return localDateTimeRegex.MatchString(input)
+}
+
+// MustParseLocalDateTimeString wraps [NewLocalDateTimeFromString] such that
+// if an error happens it generates a panic.
+func MustParseLocalDateTimeString(input string) LocalDateTime {
+	ldt, err := NewLocalDateTimeFromString(input)
+	if err != nil {
+		panic(err)
+	}
+	return ldt
+}
+
+// NewLocalDateTimeFromString creates a new [LocalDateTime] instance from a
+// `full-date "T" partial-time` string representation. As with
+// [NewDateTimeFromString], the maximum precision of fractional seconds is
+// limited to 9 places.
+func NewLocalDateTimeFromString(input string) (LocalDateTime, error) {
+	matches := localDateTimeRegex.FindStringSubmatch(input)
+
+	if matches == nil {
+		return LocalDateTime{}, fmt.Errorf("`%s` is not a local date-time string", input)
+	}
+
+	var secFrac = 0
+	secFracString := localDateTimeRegex.SubmatchWithName("secfrac")
+	if secFracString != "" {
+		secFrac = nsToInt(secFracString)
+	}
+
+	t := time.Date(
+		toInt(localDateTimeRegex.SubmatchWithName("year")),
+		time.Month(toInt(localDateTimeRegex.SubmatchWithName("month"))),
+		toInt(localDateTimeRegex.SubmatchWithName("day")),
+		toInt(localDateTimeRegex.SubmatchWithName("hour")),
+		toInt(localDateTimeRegex.SubmatchWithName("minute")),
+		toInt(localDateTimeRegex.SubmatchWithName("second")),
+		secFrac,
+		time.UTC,
+	)
+
+	return LocalDateTime{Time: t}, nil
+}
+
+// ToString serializes the [LocalDateTime] instance to a
+// `full-date "T" partial-time` string representation.
+func (ldt LocalDateTime) ToString() string {
+	return ldt.Format("2006-01-02T15:04:05.999999999")
+}
+
+// ToFullDate returns the date part of the [LocalDateTime].
+func (ldt LocalDateTime) ToFullDate() FullDate {
+	return NewFullDate(ldt.Date())
+}
+
+// ToPartialTime returns the time of day part of the [LocalDateTime].
+func (ldt LocalDateTime) ToPartialTime() PartialTime {
+	hour, minute, second := ldt.Clock()
+	return PartialTime{Time: time.Date(
+		0, time.January, 1,
+		hour, minute, second, ldt.Nanosecond(),
+		time.UTC,
+	)}
+}
+
+// At interprets the [LocalDateTime] as a wall clock time in the location
+// `loc`, resulting in a [DateTime].
+func (ldt LocalDateTime) At(loc *time.Location) DateTime {
+	year, month, day := ldt.Date()
+	hour, minute, second := ldt.Clock()
+	return DateTime{Time: time.Date(
+		year, month, day,
+		hour, minute, second, ldt.Nanosecond(),
+		loc,
+	)}
+}
+
+func (ldt LocalDateTime) MarshalJSON() ([]byte, error) {
+	if ldt.IsZero() {
+		return []byte("null"), nil
+	}
+	serialized := fmt.Sprintf(`"%s"`, ldt.ToString())
+	return []byte(serialized), nil
+}
+
+func (ldt *LocalDateTime) UnmarshalJSON(data []byte) error {
+	timeStr := strings.Trim(string(data), `"`)
+	if timeStr == "null" || timeStr == "" {
+		return nil
+	}
+
+	t, err := NewLocalDateTimeFromString(timeStr)
+	if err != nil {
+		return err
+	}
+
+	ldt.Time = t.Time
+
+	return nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The result
+// is the `full-date "T" partial-time` string representation.
+func (ldt LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(ldt.ToString()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. Empty
+// input results in a zero instance.
+func (ldt *LocalDateTime) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*ldt = LocalDateTime{}
+		return nil
+	}
+
+	parsed, err := NewLocalDateTimeFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*ldt = parsed
+
+	return nil
+}
+
+// MarshalTOML implements the TOML marshaler interface of
+// github.com/BurntSushi/toml. The result is a TOML local date-time.
+func (ldt LocalDateTime) MarshalTOML() ([]byte, error) {
+	return []byte(ldt.ToString()), nil
+}
+
+// UnmarshalTOML implements the TOML unmarshaler interface of decoders that
+// supply decoded values, e.g. github.com/BurntSushi/toml. TOML local
+// date-times, and strings that are `full-date "T" partial-time`
+// representations, are accepted.
+func (ldt *LocalDateTime) UnmarshalTOML(data any) error {
+	switch value := data.(type) {
+	case string:
+		return ldt.UnmarshalText([]byte(value))
+	case time.Time:
+		if value.Location().String() != tomlLocalDateTime {
+			return fmt.Errorf("TOML value must be a local date-time, got: %s", tomlKind(value))
+		}
+		year, month, day := value.Date()
+		hour, minute, second := value.Clock()
+		ldt.Time = time.Date(
+			year, month, day,
+			hour, minute, second, value.Nanosecond(),
+			time.UTC,
+		)
+		return nil
+	default:
+		return fmt.Errorf("TOML value must be a string or local date-time, got: %T", data)
+	}
+}
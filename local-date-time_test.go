@@ -0,0 +1,117 @@
+package rfc3339
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDateTime_IsLocalDateTimeString(t *testing.T) {
+	t.Run("returns true for local date-time string", func(t *testing.T) {
+		assert.True(t, IsLocalDateTimeString("2023-04-01T08:30:00"))
+		assert.True(t, IsLocalDateTimeString("2023-04-01t08:30:00.005"))
+	})
+
+	t.Run("returns false if not a local date-time string", func(t *testing.T) {
+		assert.False(t, IsLocalDateTimeString("2023-04-01T08:30:00Z"))
+		assert.False(t, IsLocalDateTimeString("2023-04-01T08:30:00-04:00"))
+		assert.False(t, IsLocalDateTimeString("2023-04-01"))
+		assert.False(t, IsLocalDateTimeString("2023-04-01 08:30:00"))
+	})
+}
+
+func TestLocalDateTime_MustParseLocalDateTimeString(t *testing.T) {
+	t.Run("parses without error", func(t *testing.T) {
+		expected := time.Date(2023, time.April, 1, 8, 30, 0, 5000000, time.UTC)
+		found := MustParseLocalDateTimeString("2023-04-01T08:30:00.005")
+		assert.Equal(t, expected, found.Time)
+	})
+
+	t.Run("panics for bad string", func(t *testing.T) {
+		assert.Panics(t, func() {
+			MustParseLocalDateTimeString("2023-04-01T08:30")
+		})
+	})
+}
+
+func TestLocalDateTime_NewFromString(t *testing.T) {
+	ldt, err := NewLocalDateTimeFromString("2023-04-01T08:30:00Z")
+	assert.Empty(t, ldt)
+	assert.EqualError(t, err, "`2023-04-01T08:30:00Z` is not a local date-time string")
+}
+
+func TestLocalDateTime_ToString(t *testing.T) {
+	tests := []string{"2023-04-01T08:30:00", "2023-04-01T08:30:00.005", "0000-01-01T00:00:00"}
+
+	for _, test := range tests {
+		assert.Equal(t, test, MustParseLocalDateTimeString(test).ToString())
+	}
+}
+
+func TestLocalDateTime_Parts(t *testing.T) {
+	ldt := MustParseLocalDateTimeString("2023-04-01T08:30:00.5")
+
+	t.Run("splits into date and time", func(t *testing.T) {
+		assert.Equal(t, MustParseDateString("2023-04-01"), ldt.ToFullDate())
+		assert.Equal(t, MustParsePartialTimeString("08:30:00.5"), ldt.ToPartialTime())
+	})
+
+	t.Run("places the wall clock in a location", func(t *testing.T) {
+		found := ldt.At(time.FixedZone("EDT", -4*60*60))
+		assert.Equal(t, "2023-04-01T08:30:00.5-04:00", found.ToString())
+	})
+}
+
+func TestLocalDateTime_JSON(t *testing.T) {
+	type j struct {
+		Starts LocalDateTime `json:"starts"`
+	}
+
+	t.Run("returns null for empty value", func(t *testing.T) {
+		result, err := json.Marshal(j{})
+		require.NoError(t, err)
+		assert.Equal(t, `{"starts":null}`, string(result))
+	})
+
+	t.Run("round trips values", func(t *testing.T) {
+		input := j{Starts: MustParseLocalDateTimeString("2023-04-01T08:30:00.5")}
+		result, err := json.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, `{"starts":"2023-04-01T08:30:00.5"}`, string(result))
+
+		var found j
+		err = json.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		var found j
+		err := json.Unmarshal([]byte(`{"starts":"2023-04-01T08:30:00Z"}`), &found)
+		assert.ErrorContains(t, err, "is not a local date-time string")
+	})
+}
+
+func TestLocalDateTime_Text(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := MustParseLocalDateTimeString("2023-04-01T08:30:00")
+		data, err := input.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00", string(data))
+
+		var found LocalDateTime
+		err = found.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("empty input is empty", func(t *testing.T) {
+		found := MustParseLocalDateTimeString("2023-04-01T08:30:00")
+		err := found.UnmarshalText(nil)
+		require.NoError(t, err)
+		assert.Equal(t, LocalDateTime{}, found)
+	})
+}
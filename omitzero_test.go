@@ -0,0 +1,96 @@
+//go:build go1.24
+
+package rfc3339
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The `omitzero` JSON struct tag option is only recognized from Go 1.24.
+
+func TestDateTime_JSONTags(t *testing.T) {
+	type tagged struct {
+		Plain     DateTime `json:"plain"`
+		OmitEmpty DateTime `json:"omitempty,omitempty"`
+		OmitZero  DateTime `json:"omitzero,omitzero"`
+		String    DateTime `json:"string,string"`
+	}
+
+	t.Run("handles zero values", func(t *testing.T) {
+		result, err := json.Marshal(tagged{})
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"plain":null,"omitempty":null,"string":null}`,
+			string(result),
+		)
+	})
+
+	t.Run("handles set values", func(t *testing.T) {
+		dt := MustParseDateTimeString("2023-04-01T08:30:00-04:00")
+		input := tagged{Plain: dt, OmitEmpty: dt, OmitZero: dt, String: dt}
+		result, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		expected := `{"plain":"2023-04-01T08:30:00-04:00",` +
+			`"omitempty":"2023-04-01T08:30:00-04:00",` +
+			`"omitzero":"2023-04-01T08:30:00-04:00",` +
+			`"string":"2023-04-01T08:30:00-04:00"}`
+		assert.Equal(t, expected, string(result))
+
+		var found tagged
+		err = json.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+}
+
+func TestFullDate_JSONTags(t *testing.T) {
+	type tagged struct {
+		Plain     FullDate `json:"plain"`
+		OmitEmpty FullDate `json:"omitempty,omitempty"`
+		OmitZero  FullDate `json:"omitzero,omitzero"`
+		String    FullDate `json:"string,string"`
+	}
+
+	t.Run("handles zero values", func(t *testing.T) {
+		result, err := json.Marshal(tagged{})
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"plain":null,"omitempty":null,"string":null}`,
+			string(result),
+		)
+	})
+
+	t.Run("omits zero date at another offset", func(t *testing.T) {
+		dt := MustParseDateTimeString("0001-01-01T00:00:00-04:00")
+		result, err := json.Marshal(tagged{OmitZero: dt.ToFullDate()})
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"plain":null,"omitempty":null,"string":null}`,
+			string(result),
+		)
+	})
+
+	t.Run("handles set values", func(t *testing.T) {
+		fd := MustParseDateString("2023-04-01")
+		input := tagged{Plain: fd, OmitEmpty: fd, OmitZero: fd, String: fd}
+		result, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		expected := `{"plain":"2023-04-01","omitempty":"2023-04-01",` +
+			`"omitzero":"2023-04-01","string":"2023-04-01"}`
+		assert.Equal(t, expected, string(result))
+
+		var found tagged
+		err = json.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+}
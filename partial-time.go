@@ -0,0 +1,141 @@
+package rfc3339
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jsumners/go-reggie"
+)
+
+var partialTimeRegex = reggie.MustCompile(
+	`^(?P<hour>\d{2}):(?P<minute>\d{2}):(?P<second>\d{2})(?P<secfrac>\.\d+)?$`,
+)
+
+// IsPartialTimeString verifies if an input string matches the format of
+// an RFC 3339 `partial-time` representation.
+func IsPartialTimeString(input string) bool {
+	return partialTimeRegex.MatchString(input)
+}
+
+// MustParsePartialTimeString wraps [NewPartialTimeFromString] such that if an
+// error happens it generates a panic.
+func MustParsePartialTimeString(input string) PartialTime {
+	pt, err := NewPartialTimeFromString(input)
+	if err != nil {
+		panic(err)
+	}
+	return pt
+}
+
+// NewPartialTimeFromString creates a new [PartialTime] instance from an
+// RFC 3339 `partial-time` string representation. As with
+// [NewDateTimeFromString], the maximum precision of fractional seconds is
+// limited to 9 places.
+func NewPartialTimeFromString(input string) (PartialTime, error) {
+	matches := partialTimeRegex.FindStringSubmatch(input)
+
+	if matches == nil {
+		return PartialTime{}, fmt.Errorf("`%s` is not a partial-time string", input)
+	}
+
+	hour := partialTimeRegex.SubmatchWithName("hour")
+	minute := partialTimeRegex.SubmatchWithName("minute")
+	second := partialTimeRegex.SubmatchWithName("second")
+
+	var secFrac = 0
+	secFracString := partialTimeRegex.SubmatchWithName("secfrac")
+	if secFracString != "" {
+		secFrac = nsToInt(secFracString)
+	}
+
+	t := time.Date(
+		0, time.January, 1,
+		toInt(hour), toInt(minute), toInt(second), secFrac,
+		time.UTC,
+	)
+
+	return PartialTime{Time: t}, nil
+}
+
+// ToString serializes the [PartialTime] instance to an RFC 3339 partial-time
+// string representation.
+func (pt PartialTime) ToString() string {
+	return pt.Format("15:04:05.999999999")
+}
+
+func (pt PartialTime) MarshalJSON() ([]byte, error) {
+	if pt.IsZero() {
+		return []byte("null"), nil
+	}
+	serialized := fmt.Sprintf(`"%s"`, pt.ToString())
+	return []byte(serialized), nil
+}
+
+func (pt *PartialTime) UnmarshalJSON(data []byte) error {
+	timeStr := strings.Trim(string(data), `"`)
+	if timeStr == "null" || timeStr == "" {
+		return nil
+	}
+
+	t, err := NewPartialTimeFromString(timeStr)
+	if err != nil {
+		return err
+	}
+
+	pt.Time = t.Time
+
+	return nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface. The result
+// is the RFC 3339 partial-time string representation.
+func (pt PartialTime) MarshalText() ([]byte, error) {
+	return []byte(pt.ToString()), nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. Empty
+// input results in a zero instance.
+func (pt *PartialTime) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*pt = PartialTime{}
+		return nil
+	}
+
+	parsed, err := NewPartialTimeFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*pt = parsed
+
+	return nil
+}
+
+// MarshalTOML implements the TOML marshaler interface of
+// github.com/BurntSushi/toml. The result is a TOML local time.
+func (pt PartialTime) MarshalTOML() ([]byte, error) {
+	return []byte(pt.ToString()), nil
+}
+
+// UnmarshalTOML implements the TOML unmarshaler interface of decoders that
+// supply decoded values, e.g. github.com/BurntSushi/toml. TOML local times,
+// and strings that are RFC 3339 partial-time representations, are accepted.
+func (pt *PartialTime) UnmarshalTOML(data any) error {
+	switch value := data.(type) {
+	case string:
+		return pt.UnmarshalText([]byte(value))
+	case time.Time:
+		if value.Location().String() != tomlLocalTime {
+			return fmt.Errorf("TOML value must be a local time, got: %s", tomlKind(value))
+		}
+		hour, minute, second := value.Clock()
+		pt.Time = time.Date(
+			0, time.January, 1,
+			hour, minute, second, value.Nanosecond(),
+			time.UTC,
+		)
+		return nil
+	default:
+		return fmt.Errorf("TOML value must be a string or local time, got: %T", data)
+	}
+}
@@ -0,0 +1,109 @@
+package rfc3339
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartialTime_IsPartialTimeString(t *testing.T) {
+	t.Run("returns true for time string", func(t *testing.T) {
+		assert.True(t, IsPartialTimeString("08:30:00"))
+		assert.True(t, IsPartialTimeString("08:30:00.005"))
+	})
+
+	t.Run("returns false if not a time string", func(t *testing.T) {
+		assert.False(t, IsPartialTimeString("08:30"))
+		assert.False(t, IsPartialTimeString("08:30:00Z"))
+	})
+}
+
+func TestPartialTime_MustParsePartialTimeString(t *testing.T) {
+	t.Run("parses without error", func(t *testing.T) {
+		expected := time.Date(0, time.January, 1, 8, 30, 0, 5000000, time.UTC)
+		found := MustParsePartialTimeString("08:30:00.005")
+		assert.Equal(t, expected, found.Time)
+	})
+
+	t.Run("panics for bad string", func(t *testing.T) {
+		assert.Panics(t, func() {
+			MustParsePartialTimeString("08:30")
+		})
+	})
+}
+
+func TestPartialTime_NewFromString(t *testing.T) {
+	t.Run("returns error for bad input", func(t *testing.T) {
+		pt, err := NewPartialTimeFromString("8:30:00")
+		assert.Empty(t, pt)
+		assert.EqualError(t, err, "`8:30:00` is not a partial-time string")
+	})
+
+	t.Run("midnight is not zero", func(t *testing.T) {
+		pt, err := NewPartialTimeFromString("00:00:00")
+		require.NoError(t, err)
+		assert.False(t, pt.IsZero())
+	})
+}
+
+func TestPartialTime_ToString(t *testing.T) {
+	tests := []string{"08:30:00", "08:30:00.005", "23:59:59.999999999"}
+
+	for _, test := range tests {
+		assert.Equal(t, test, MustParsePartialTimeString(test).ToString())
+	}
+}
+
+func TestPartialTime_JSON(t *testing.T) {
+	type j struct {
+		Opens PartialTime `json:"opens"`
+	}
+
+	t.Run("returns null for empty value", func(t *testing.T) {
+		result, err := json.Marshal(j{})
+		require.NoError(t, err)
+		assert.Equal(t, `{"opens":null}`, string(result))
+	})
+
+	t.Run("round trips values", func(t *testing.T) {
+		input := j{Opens: MustParsePartialTimeString("08:30:00.5")}
+		result, err := json.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, `{"opens":"08:30:00.5"}`, string(result))
+
+		var found j
+		err = json.Unmarshal(result, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		var found j
+		err := json.Unmarshal([]byte(`{"opens":"08:30"}`), &found)
+		assert.ErrorContains(t, err, "is not a partial-time string")
+	})
+}
+
+func TestPartialTime_Text(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := MustParsePartialTimeString("08:30:00")
+		data, err := input.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "08:30:00", string(data))
+
+		var found PartialTime
+		err = found.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("empty input is empty", func(t *testing.T) {
+		found := MustParsePartialTimeString("08:30:00")
+		err := found.UnmarshalText(nil)
+		require.NoError(t, err)
+		assert.Equal(t, PartialTime{}, found)
+	})
+}
@@ -0,0 +1,7 @@
+//go:build race
+
+package rfc3339
+
+// raceEnabled reports whether the tests are built with the race detector,
+// which changes the number of allocations.
+const raceEnabled = true
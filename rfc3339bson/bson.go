@@ -0,0 +1,56 @@
+package rfc3339bson
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// ErrLossyConversion is returned when encoding a value as a native BSON
+// datetime would lose information, and the type does not permit it.
+var ErrLossyConversion = errors.New("lossy conversion to BSON datetime")
+
+// marshalNull encodes BSON null.
+func marshalNull() (byte, []byte, error) {
+	return byte(bson.TypeNull), nil, nil
+}
+
+// marshalDateTime encodes the instant as a native BSON datetime.
+func marshalDateTime(t time.Time) (byte, []byte, error) {
+	typ, data, err := bson.MarshalValue(bson.NewDateTimeFromTime(t))
+	return byte(typ), data, err
+}
+
+// unmarshalDateTime decodes a native BSON datetime to an instant at UTC.
+func unmarshalDateTime(typ byte, data []byte) (time.Time, error) {
+	if bson.Type(typ) != bson.TypeDateTime {
+		return time.Time{}, fmt.Errorf("BSON value must be a datetime, got: %s", bson.Type(typ))
+	}
+
+	var dt bson.DateTime
+	if err := bson.UnmarshalValue(bson.TypeDateTime, data, &dt); err != nil {
+		return time.Time{}, err
+	}
+
+	return dt.Time().UTC(), nil
+}
+
+// marshalString encodes a BSON string.
+func marshalString(str string) (byte, []byte, error) {
+	typ, data, err := bson.MarshalValue(str)
+	return byte(typ), data, err
+}
+
+// unmarshalString decodes a BSON string.
+func unmarshalString(typ byte, data []byte) (string, error) {
+	if bson.Type(typ) != bson.TypeString {
+		return "", fmt.Errorf("BSON value must be a string, got: %s", bson.Type(typ))
+	}
+
+	var str string
+	err := bson.UnmarshalValue(bson.TypeString, data, &str)
+
+	return str, err
+}
@@ -0,0 +1,113 @@
+package rfc3339bson
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// DateTime encodes an [rfc3339.DateTime] as a native BSON datetime. Only
+// values at the UTC (+00:00) offset with at most millisecond precision can
+// be encoded; any other value results in an [ErrLossyConversion] error.
+type DateTime struct {
+	rfc3339.DateTime
+}
+
+// LossyDateTime encodes an [rfc3339.DateTime] as a native BSON datetime. The
+// UTC offset is discarded, and the value is truncated to millisecond
+// precision. Decoded values are at the UTC (+00:00) offset.
+type LossyDateTime struct {
+	rfc3339.DateTime
+}
+
+// StringDateTime encodes an [rfc3339.DateTime] as an RFC 3339 date-time
+// string. The UTC offset and precision are preserved.
+type StringDateTime struct {
+	rfc3339.DateTime
+}
+
+// MarshalBSONValue implements the [bson.ValueMarshaler] interface.
+func (dt DateTime) MarshalBSONValue() (byte, []byte, error) {
+	if dt.IsZero() {
+		return marshalNull()
+	}
+
+	if _, offset := dt.Zone(); offset != 0 {
+		return 0, nil, fmt.Errorf("%w: offset of %s", ErrLossyConversion, dt.ToString())
+	}
+	if dt.Nanosecond()%int(time.Millisecond) != 0 {
+		return 0, nil, fmt.Errorf("%w: precision of %s", ErrLossyConversion, dt.ToString())
+	}
+
+	return marshalDateTime(dt.Time)
+}
+
+// UnmarshalBSONValue implements the [bson.ValueUnmarshaler] interface.
+func (dt *DateTime) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeNull {
+		dt.DateTime = rfc3339.DateTime{}
+		return nil
+	}
+
+	t, err := unmarshalDateTime(typ, data)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = rfc3339.NewFromTime(t)
+
+	return nil
+}
+
+// MarshalBSONValue implements the [bson.ValueMarshaler] interface.
+func (dt LossyDateTime) MarshalBSONValue() (byte, []byte, error) {
+	if dt.IsZero() {
+		return marshalNull()
+	}
+	return marshalDateTime(dt.Time)
+}
+
+// UnmarshalBSONValue implements the [bson.ValueUnmarshaler] interface.
+func (dt *LossyDateTime) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeNull {
+		dt.DateTime = rfc3339.DateTime{}
+		return nil
+	}
+
+	t, err := unmarshalDateTime(typ, data)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = rfc3339.NewFromTime(t)
+
+	return nil
+}
+
+// MarshalBSONValue implements the [bson.ValueMarshaler] interface.
+func (dt StringDateTime) MarshalBSONValue() (byte, []byte, error) {
+	if dt.IsZero() {
+		return marshalNull()
+	}
+	return marshalString(dt.ToString())
+}
+
+// UnmarshalBSONValue implements the [bson.ValueUnmarshaler] interface.
+func (dt *StringDateTime) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeNull {
+		dt.DateTime = rfc3339.DateTime{}
+		return nil
+	}
+
+	str, err := unmarshalString(typ, data)
+	if err != nil {
+		return err
+	}
+	parsed, err := rfc3339.NewDateTimeFromString(str)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = parsed
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package rfc3339bson
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type event struct {
+	Native DateTime       `bson:"native"`
+	Lossy  LossyDateTime  `bson:"lossy"`
+	String StringDateTime `bson:"string"`
+}
+
+func TestDateTime(t *testing.T) {
+	t.Run("round trips UTC millisecond values", func(t *testing.T) {
+		input := event{
+			Native: DateTime{rfc3339.MustParseDateTimeString("2023-04-01T12:30:00.005Z")},
+		}
+		data, err := bson.Marshal(input)
+		require.NoError(t, err)
+
+		var raw bson.M
+		err = bson.Unmarshal(data, &raw)
+		require.NoError(t, err)
+		assert.Equal(t, bson.NewDateTimeFromTime(input.Native.Time), raw["native"])
+		assert.Nil(t, raw["lossy"])
+
+		var found event
+		err = bson.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for lossy values", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{
+				"2023-04-01T08:30:00-04:00",
+				"lossy conversion to BSON datetime: offset of 2023-04-01T08:30:00-04:00",
+			},
+			{
+				"2023-04-01T12:30:00.0005Z",
+				"lossy conversion to BSON datetime: precision of 2023-04-01T12:30:00.0005Z",
+			},
+		}
+
+		for _, test := range tests {
+			input := event{Native: DateTime{rfc3339.MustParseDateTimeString(test.input)}}
+			_, err := bson.Marshal(input)
+			assert.ErrorIs(t, err, ErrLossyConversion)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+
+	t.Run("returns error for strings", func(t *testing.T) {
+		data, err := bson.Marshal(bson.M{"native": "2023-04-01T12:30:00Z"})
+		require.NoError(t, err)
+
+		var found event
+		err = bson.Unmarshal(data, &found)
+		assert.ErrorContains(t, err, "BSON value must be a datetime, got: string")
+	})
+}
+
+func TestLossyDateTime(t *testing.T) {
+	input := event{
+		Lossy: LossyDateTime{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.0051-04:00")},
+	}
+	data, err := bson.Marshal(input)
+	require.NoError(t, err)
+
+	var found event
+	err = bson.Unmarshal(data, &found)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-04-01T12:30:00.005Z", found.Lossy.ToString())
+	assert.True(t, found.Lossy.Equal(input.Lossy.Truncate(time.Millisecond)))
+}
+
+func TestStringDateTime(t *testing.T) {
+	t.Run("preserves offset and precision", func(t *testing.T) {
+		input := event{
+			String: StringDateTime{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.123456789-04:00")},
+		}
+		data, err := bson.Marshal(input)
+		require.NoError(t, err)
+
+		var raw bson.M
+		err = bson.Unmarshal(data, &raw)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00.123456789-04:00", raw["string"])
+
+		var found event
+		err = bson.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    bson.M
+			expected string
+		}{
+			{bson.M{"string": "2023-04-01 12:30:00Z"}, "input is not a date-time string"},
+			{bson.M{"string": bson.DateTime(0)}, "BSON value must be a string, got: UTC datetime"},
+		}
+
+		for _, test := range tests {
+			data, err := bson.Marshal(test.input)
+			require.NoError(t, err)
+
+			var found event
+			err = bson.Unmarshal(data, &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
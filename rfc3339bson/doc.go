@@ -0,0 +1,19 @@
+// Package rfc3339bson provides BSON encodings of the rfc3339 types for use
+// with the MongoDB driver, go.mongodb.org/mongo-driver/v2/bson. Each encoding
+// is a distinct wrapper type, so the encoding is chosen by the type of a
+// struct field:
+//
+//   - [DateTime] uses the native BSON datetime, milliseconds since the Unix
+//     epoch in UTC. Encoding fails with [ErrLossyConversion] if the UTC
+//     offset or sub-millisecond precision would be lost.
+//   - [LossyDateTime] uses the native BSON datetime, discarding the UTC
+//     offset and truncating to millisecond precision.
+//   - [StringDateTime] uses an RFC 3339 date-time string, preserving the UTC
+//     offset and precision.
+//   - [FullDate] uses the native BSON datetime at midnight UTC.
+//   - [StringFullDate] uses an RFC 3339 full-date string.
+//
+// Decoding is strict: native types only accept BSON datetimes, and string
+// types only accept strings that are parsed with the rfc3339 parsers. Zero
+// values are encoded as BSON null, and BSON null decodes to zero values.
+package rfc3339bson
@@ -0,0 +1,80 @@
+package rfc3339bson
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// FullDate encodes an [rfc3339.FullDate] as a native BSON datetime at
+// midnight UTC of the date. Only BSON datetimes at midnight UTC can be
+// decoded.
+type FullDate struct {
+	rfc3339.FullDate
+}
+
+// StringFullDate encodes an [rfc3339.FullDate] as an RFC 3339 full-date
+// string.
+type StringFullDate struct {
+	rfc3339.FullDate
+}
+
+// MarshalBSONValue implements the [bson.ValueMarshaler] interface.
+func (fd FullDate) MarshalBSONValue() (byte, []byte, error) {
+	if fd.IsZero() {
+		return marshalNull()
+	}
+
+	year, month, day := fd.Date()
+	return marshalDateTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// UnmarshalBSONValue implements the [bson.ValueUnmarshaler] interface.
+func (fd *FullDate) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeNull {
+		fd.FullDate = rfc3339.FullDate{}
+		return nil
+	}
+
+	t, err := unmarshalDateTime(typ, data)
+	if err != nil {
+		return err
+	}
+	if !t.Equal(t.Truncate(24 * time.Hour)) {
+		return fmt.Errorf("BSON datetime is not midnight UTC: %s", t.Format(time.RFC3339Nano))
+	}
+
+	fd.FullDate = rfc3339.NewFullDate(t.Date())
+
+	return nil
+}
+
+// MarshalBSONValue implements the [bson.ValueMarshaler] interface.
+func (fd StringFullDate) MarshalBSONValue() (byte, []byte, error) {
+	if fd.IsZero() {
+		return marshalNull()
+	}
+	return marshalString(fd.ToString())
+}
+
+// UnmarshalBSONValue implements the [bson.ValueUnmarshaler] interface.
+func (fd *StringFullDate) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeNull {
+		fd.FullDate = rfc3339.FullDate{}
+		return nil
+	}
+
+	str, err := unmarshalString(typ, data)
+	if err != nil {
+		return err
+	}
+	parsed, err := rfc3339.NewFullDateFromString(str)
+	if err != nil {
+		return err
+	}
+	fd.FullDate = parsed
+
+	return nil
+}
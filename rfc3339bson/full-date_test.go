@@ -0,0 +1,84 @@
+package rfc3339bson
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type policy struct {
+	Native FullDate       `bson:"native"`
+	String StringFullDate `bson:"string"`
+}
+
+func TestFullDate(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := policy{Native: FullDate{rfc3339.MustParseDateString("2023-04-01")}}
+		data, err := bson.Marshal(input)
+		require.NoError(t, err)
+
+		var raw bson.M
+		err = bson.Unmarshal(data, &raw)
+		require.NoError(t, err)
+		expected := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, bson.NewDateTimeFromTime(expected), raw["native"])
+		assert.Nil(t, raw["string"])
+
+		var found policy
+		err = bson.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("encodes the date in the instance location", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T23:30:00-04:00")
+		data, err := bson.Marshal(policy{Native: FullDate{dt.ToFullDate()}})
+		require.NoError(t, err)
+
+		var found policy
+		err = bson.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01", found.Native.ToString())
+	})
+
+	t.Run("returns error for datetimes after midnight", func(t *testing.T) {
+		value := time.Date(2023, 4, 1, 12, 30, 0, 0, time.UTC)
+		data, err := bson.Marshal(bson.M{"native": bson.NewDateTimeFromTime(value)})
+		require.NoError(t, err)
+
+		var found policy
+		err = bson.Unmarshal(data, &found)
+		assert.ErrorContains(t, err, "BSON datetime is not midnight UTC: 2023-04-01T12:30:00Z")
+	})
+}
+
+func TestStringFullDate(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := policy{String: StringFullDate{rfc3339.MustParseDateString("2023-04-01")}}
+		data, err := bson.Marshal(input)
+		require.NoError(t, err)
+
+		var raw bson.M
+		err = bson.Unmarshal(data, &raw)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01", raw["string"])
+
+		var found policy
+		err = bson.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		data, err := bson.Marshal(bson.M{"string": "2023/04/01"})
+		require.NoError(t, err)
+
+		var found policy
+		err = bson.Unmarshal(data, &found)
+		assert.ErrorContains(t, err, "`2023/04/01` is not a full-date string")
+	})
+}
@@ -0,0 +1,41 @@
+package rfc3339cbor
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// The CBOR tag numbers supported by this package.
+const (
+	TagDateTimeString   uint64 = 0
+	TagDateTimeEpoch    uint64 = 1
+	TagFullDateEpoch    uint64 = 100
+	TagExtendedDateTime uint64 = 1001
+	TagFullDateString   uint64 = 1004
+)
+
+// cborNull is the encoding of the CBOR null simple value.
+var cborNull = []byte{0xf6}
+
+// isNull reports whether data is the CBOR null or undefined simple value.
+func isNull(data []byte) bool {
+	return len(data) == 1 && (data[0] == 0xf6 || data[0] == 0xf7)
+}
+
+// decodeTag decodes a tagged CBOR data item, and verifies the tag number is
+// one of the expected numbers.
+func decodeTag(data []byte, production string, numbers ...uint64) (cbor.RawTag, error) {
+	var tag cbor.RawTag
+	if err := tag.UnmarshalCBOR(data); err != nil {
+		return tag, fmt.Errorf("%s must be a tagged CBOR data item: %w", production, err)
+	}
+
+	for _, number := range numbers {
+		if tag.Number == number {
+			return tag, nil
+		}
+	}
+
+	return tag, fmt.Errorf("unsupported CBOR tag for %s: %d", production, tag.Number)
+}
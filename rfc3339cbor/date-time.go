@@ -0,0 +1,219 @@
+package rfc3339cbor
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/jsumners/go-rfc3339"
+)
+
+// DateTime encodes an [rfc3339.DateTime] as CBOR tag 0, an RFC 3339
+// date-time text string. The UTC offset and precision are preserved.
+type DateTime struct {
+	rfc3339.DateTime
+}
+
+// EpochDateTime encodes an [rfc3339.DateTime] as CBOR tag 1, seconds since
+// the Unix epoch. Whole seconds are encoded as integers, and any other value
+// as a floating-point number, which is limited to roughly microsecond
+// precision. The UTC offset is not preserved; decoded values are at the UTC
+// (+00:00) offset.
+type EpochDateTime struct {
+	rfc3339.DateTime
+}
+
+// ExtendedDateTime encodes an [rfc3339.DateTime] as CBOR tag 1001, a map of
+// integer seconds (key 1) and nanoseconds (key -9) since the Unix epoch.
+// Precision is preserved, but the UTC offset is not; decoded values are at
+// the UTC (+00:00) offset.
+type ExtendedDateTime struct {
+	rfc3339.DateTime
+}
+
+// extendedTime is the tag 1001 map written by [ExtendedDateTime]. A struct
+// is used, rather than a map, so that the keys are always encoded in the same
+// order.
+type extendedTime struct {
+	Seconds     int64 `cbor:"1,keyasint"`
+	Nanoseconds int64 `cbor:"-9,keyasint,omitempty"`
+}
+
+// MarshalCBOR implements the [cbor.Marshaler] interface.
+func (dt DateTime) MarshalCBOR() ([]byte, error) {
+	if dt.IsZero() {
+		return cborNull, nil
+	}
+	return cbor.Marshal(cbor.Tag{Number: TagDateTimeString, Content: dt.ToString()})
+}
+
+// UnmarshalCBOR implements the [cbor.Unmarshaler] interface.
+func (dt *DateTime) UnmarshalCBOR(data []byte) error {
+	parsed, err := decodeDateTime(data)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = parsed
+	return nil
+}
+
+// MarshalCBOR implements the [cbor.Marshaler] interface.
+func (dt EpochDateTime) MarshalCBOR() ([]byte, error) {
+	if dt.IsZero() {
+		return cborNull, nil
+	}
+
+	var content any = dt.Unix()
+	if dt.Nanosecond() != 0 {
+		content = float64(dt.Unix()) + float64(dt.Nanosecond())/float64(time.Second)
+	}
+
+	return cbor.Marshal(cbor.Tag{Number: TagDateTimeEpoch, Content: content})
+}
+
+// UnmarshalCBOR implements the [cbor.Unmarshaler] interface.
+func (dt *EpochDateTime) UnmarshalCBOR(data []byte) error {
+	parsed, err := decodeDateTime(data)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = parsed
+	return nil
+}
+
+// MarshalCBOR implements the [cbor.Marshaler] interface.
+func (dt ExtendedDateTime) MarshalCBOR() ([]byte, error) {
+	if dt.IsZero() {
+		return cborNull, nil
+	}
+
+	content := extendedTime{Seconds: dt.Unix(), Nanoseconds: int64(dt.Nanosecond())}
+	return cbor.Marshal(cbor.Tag{Number: TagExtendedDateTime, Content: content})
+}
+
+// UnmarshalCBOR implements the [cbor.Unmarshaler] interface.
+func (dt *ExtendedDateTime) UnmarshalCBOR(data []byte) error {
+	parsed, err := decodeDateTime(data)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = parsed
+	return nil
+}
+
+// decodeDateTime decodes any of the supported date-time tags.
+func decodeDateTime(data []byte) (rfc3339.DateTime, error) {
+	if isNull(data) {
+		return rfc3339.DateTime{}, nil
+	}
+
+	tag, err := decodeTag(
+		data, "date-time",
+		TagDateTimeString, TagDateTimeEpoch, TagExtendedDateTime,
+	)
+	if err != nil {
+		return rfc3339.DateTime{}, err
+	}
+
+	switch tag.Number {
+	case TagDateTimeString:
+		var content string
+		if err := cbor.Unmarshal(tag.Content, &content); err != nil {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 0 content must be a text string: %w", err)
+		}
+		return rfc3339.NewDateTimeFromString(content)
+
+	case TagDateTimeEpoch:
+		var content any
+		if err := cbor.Unmarshal(tag.Content, &content); err != nil {
+			return rfc3339.DateTime{}, err
+		}
+		return decodeEpoch(content)
+
+	default:
+		return decodeExtended(tag.Content)
+	}
+}
+
+// minEpochSecond and maxEpochSecond bound the seconds since the Unix epoch
+// of the instants that can be written as RFC 3339 date-times,
+// 0000-01-01T00:00:00Z through 9999-12-31T23:59:59Z.
+const (
+	minEpochSecond = -62167219200
+	maxEpochSecond = 253402300799
+)
+
+// decodeEpoch converts the content of a tag 1 data item. Instants outside
+// the years 0000 through 9999 are rejected.
+func decodeEpoch(content any) (rfc3339.DateTime, error) {
+	switch value := content.(type) {
+	case uint64:
+		if value > maxEpochSecond {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1 content out of range: %d", value)
+		}
+		return rfc3339.NewFromTime(time.Unix(int64(value), 0).UTC()), nil
+	case int64:
+		if value < minEpochSecond || value > maxEpochSecond {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1 content out of range: %d", value)
+		}
+		return rfc3339.NewFromTime(time.Unix(value, 0).UTC()), nil
+	case float64:
+		if math.IsNaN(value) || value < minEpochSecond || value >= maxEpochSecond+1 {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1 content out of range: %v", value)
+		}
+		sec, frac := math.Modf(value)
+		nsec := math.Round(frac * float64(time.Second))
+		t := time.Unix(int64(sec), int64(nsec)).UTC()
+		if t.Unix() > maxEpochSecond {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1 content out of range: %v", value)
+		}
+		return rfc3339.NewFromTime(t), nil
+	default:
+		return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1 content must be a number, got: %T", content)
+	}
+}
+
+// decodeExtended converts the content of a tag 1001 data item. Only the base
+// time (key 1), and at most one of the fractional time keys -3, -6, and -9
+// are accepted. Instants outside the years 0000 through 9999 are rejected.
+func decodeExtended(content []byte) (rfc3339.DateTime, error) {
+	var fields map[int]int64
+	if err := cbor.Unmarshal(content, &fields); err != nil {
+		return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1001 content must be a map of integers: %w", err)
+	}
+
+	sec, ok := fields[1]
+	if !ok {
+		return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1001 content is missing key 1")
+	}
+	if sec < minEpochSecond || sec > maxEpochSecond {
+		return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1001 key 1 out of range: %d", sec)
+	}
+
+	var nsec int64
+	for key, value := range fields {
+		var scale int64
+		switch key {
+		case 1:
+			continue
+		case -3:
+			scale = int64(time.Millisecond)
+		case -6:
+			scale = int64(time.Microsecond)
+		case -9:
+			scale = int64(time.Nanosecond)
+		default:
+			return rfc3339.DateTime{}, fmt.Errorf("unsupported CBOR tag 1001 key: %d", key)
+		}
+		if len(fields) > 2 {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1001 content has more than one fraction key")
+		}
+		if value < 0 || value >= int64(time.Second)/scale {
+			return rfc3339.DateTime{}, fmt.Errorf("CBOR tag 1001 key %d out of range: %d", key, value)
+		}
+		nsec = value * scale
+	}
+
+	return rfc3339.NewFromTime(time.Unix(sec, nsec).UTC()), nil
+}
@@ -0,0 +1,246 @@
+package rfc3339cbor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustHex(t *testing.T, input string) []byte {
+	t.Helper()
+	data, err := hex.DecodeString(input)
+	require.NoError(t, err)
+	return data
+}
+
+func TestDateTime(t *testing.T) {
+	t.Run("encodes RFC 8949 example", func(t *testing.T) {
+		input := DateTime{rfc3339.MustParseDateTimeString("2013-03-21T20:04:00Z")}
+		data, err := cbor.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, "c074323031332d30332d32315432303a30343a30305a", hex.EncodeToString(data))
+
+		var found DateTime
+		err = cbor.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("preserves offset and precision", func(t *testing.T) {
+		input := DateTime{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.123456789-04:00")}
+		data, err := cbor.Marshal(input)
+		require.NoError(t, err)
+
+		var found DateTime
+		err = cbor.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("encodes zero value as null", func(t *testing.T) {
+		data, err := cbor.Marshal(DateTime{})
+		require.NoError(t, err)
+		assert.Equal(t, "f6", hex.EncodeToString(data))
+
+		found := DateTime{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00Z")}
+		err = cbor.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.True(t, found.IsZero())
+	})
+
+	t.Run("decodes any date-time tag", func(t *testing.T) {
+		inputs := []string{
+			"c074323031332d30332d32315432303a30343a30305a",
+			"c11a514b67b0",
+			"d903e9a1011a514b67b0",
+		}
+
+		for _, input := range inputs {
+			var found DateTime
+			err := cbor.Unmarshal(mustHex(t, input), &found)
+			require.NoError(t, err)
+			assert.Equal(t, "2013-03-21T20:04:00Z", found.ToString())
+		}
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// "2013-03-21T20:04:00Z" without a tag.
+			{"74323031332d30332d32315432303a30343a30305a", "date-time must be a tagged CBOR data item"},
+			// 1004("2013-03-21")
+			{"d903ec6a323031332d30332d3231", "unsupported CBOR tag for date-time: 1004"},
+			// 0("2013-03-21 20:04:00Z")
+			{"c074323031332d30332d32312032303a30343a30305a", "input is not a date-time string: 2013-03-21 20:04:00Z"},
+			// 0(1363896240)
+			{"c01a514b67b0", "tag number 0 must be followed by text string"},
+		}
+
+		for _, test := range tests {
+			var found DateTime
+			err := cbor.Unmarshal(mustHex(t, test.input), &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
+
+func TestEpochDateTime(t *testing.T) {
+	t.Run("encodes RFC 8949 examples", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"2013-03-21T20:04:00Z", "c11a514b67b0"},
+			{"2013-03-21T20:04:00.5Z", "c1fb41d452d9ec200000"},
+		}
+
+		for _, test := range tests {
+			input := EpochDateTime{rfc3339.MustParseDateTimeString(test.input)}
+			data, err := cbor.Marshal(input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(data))
+
+			var found EpochDateTime
+			err = cbor.Unmarshal(data, &found)
+			require.NoError(t, err)
+			assert.Equal(t, input, found)
+		}
+	})
+
+	t.Run("decodes to UTC", func(t *testing.T) {
+		input := EpochDateTime{rfc3339.MustParseDateTimeString("2013-03-21T16:04:00-04:00")}
+		data, err := cbor.Marshal(input)
+		require.NoError(t, err)
+
+		var found EpochDateTime
+		err = cbor.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, "2013-03-21T20:04:00Z", found.ToString())
+	})
+
+	t.Run("decodes negative values", func(t *testing.T) {
+		var found EpochDateTime
+		err := cbor.Unmarshal(mustHex(t, "c13a0001517f"), &found)
+		require.NoError(t, err)
+		assert.Equal(t, "1969-12-31T00:00:00Z", found.ToString())
+	})
+
+	t.Run("decodes the first and last instants of the RFC 3339 years", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// 1(-62167219200)
+			{"c13b0000000e79747bff", "0000-01-01T00:00:00Z"},
+			// 1(253402300799)
+			{"c11b0000003afff4417f", "9999-12-31T23:59:59Z"},
+		}
+
+		for _, test := range tests {
+			var found EpochDateTime
+			err := cbor.Unmarshal(mustHex(t, test.input), &found)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, found.ToString())
+		}
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// 1("1363896240")
+			{"c16a31333633383936323430", "tag number 1 must be followed by integer or floating-point number"},
+			// 1(NaN)
+			{"c1f97e00", "CBOR tag 1 content out of range: NaN"},
+			// 1(18446744073709551615)
+			{"c11bffffffffffffffff", "CBOR tag 1 content out of range: 18446744073709551615"},
+			// 1(253402300800), 10000-01-01T00:00:00Z
+			{"c11b0000003afff44180", "CBOR tag 1 content out of range: 253402300800"},
+			// 1(-62167219201), -0001-12-31T23:59:59Z
+			{"c13b0000000e79747c00", "CBOR tag 1 content out of range: -62167219201"},
+			// 1(9223372036854775807)
+			{"c11b7fffffffffffffff", "CBOR tag 1 content out of range: 9223372036854775807"},
+			// 1(253402300800.0)
+			{"c1fb424d7ffa20c00000", "CBOR tag 1 content out of range: 2.534023008e+11"},
+			// 1(-Infinity)
+			{"c1f9fc00", "CBOR tag 1 content out of range: -Inf"},
+		}
+
+		for _, test := range tests {
+			var found EpochDateTime
+			err := cbor.Unmarshal(mustHex(t, test.input), &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
+
+func TestExtendedDateTime(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// 1001({1: 1363896240})
+			{"2013-03-21T20:04:00Z", "d903e9a1011a514b67b0"},
+			// 1001({1: 1363896240, -9: 123456789})
+			{"2013-03-21T20:04:00.123456789Z", "d903e9a2011a514b67b0281a075bcd15"},
+		}
+
+		for _, test := range tests {
+			input := ExtendedDateTime{rfc3339.MustParseDateTimeString(test.input)}
+			data, err := cbor.Marshal(input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(data))
+
+			var found ExtendedDateTime
+			err = cbor.Unmarshal(data, &found)
+			require.NoError(t, err)
+			assert.Equal(t, input, found)
+		}
+	})
+
+	t.Run("decodes millisecond fractions", func(t *testing.T) {
+		// 1001({1: 1363896240, -3: 500})
+		var found ExtendedDateTime
+		err := cbor.Unmarshal(mustHex(t, "d903e9a2011a514b67b0221901f4"), &found)
+		require.NoError(t, err)
+		assert.Equal(t, "2013-03-21T20:04:00.5Z", found.ToString())
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// 1001({-9: 5})
+			{"d903e9a12805", "CBOR tag 1001 content is missing key 1"},
+			// 1001({1: 0, 2: 5})
+			{"d903e9a201000205", "unsupported CBOR tag 1001 key: 2"},
+			// 1001({1: 0, -3: 1000})
+			{"d903e9a20100221903e8", "CBOR tag 1001 key -3 out of range: 1000"},
+			// 1001({1: 0, -3: 4611686018427387904}), which overflows when scaled
+			{"d903e9a20100221b4000000000000000", "CBOR tag 1001 key -3 out of range: 4611686018427387904"},
+			// 1001({1: 253402300800})
+			{"d903e9a1011b0000003afff44180", "CBOR tag 1001 key 1 out of range: 253402300800"},
+			// 1001({1: -62167219201})
+			{"d903e9a1013b0000000e79747c00", "CBOR tag 1001 key 1 out of range: -62167219201"},
+			// 1001({1: 0, -3: 1, -9: 1})
+			{"d903e9a3010022012801", "CBOR tag 1001 content has more than one fraction key"},
+			// 1001([1])
+			{"d903e98101", "CBOR tag 1001 content must be a map of integers"},
+		}
+
+		for _, test := range tests {
+			var found ExtendedDateTime
+			err := cbor.Unmarshal(mustHex(t, test.input), &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
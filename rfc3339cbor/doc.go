@@ -0,0 +1,19 @@
+// Package rfc3339cbor provides CBOR (RFC 8949) encodings of the rfc3339
+// types for use with github.com/fxamacker/cbor/v2. Each encoding is a
+// distinct wrapper type, so the encoding is chosen by the type of a struct
+// field:
+//
+//   - [DateTime] uses tag 0, an RFC 3339 date-time text string.
+//   - [EpochDateTime] uses tag 1, numeric seconds since the Unix epoch.
+//   - [ExtendedDateTime] uses tag 1001 (RFC 9581), a map of integer seconds
+//     and nanoseconds since the Unix epoch.
+//   - [FullDate] uses tag 1004 (RFC 8943), an RFC 3339 full-date text string.
+//   - [EpochFullDate] uses tag 100 (RFC 8943), integer days since the Unix
+//     epoch.
+//
+// Decoding is strict: data must carry one of the tags of its family, and
+// text strings are parsed with the rfc3339 parsers. Any of the date-time
+// tags may be decoded into any of the date-time types, and likewise for the
+// full-date tags. Zero values are encoded as CBOR null, and CBOR null or
+// undefined decode to zero values.
+package rfc3339cbor
@@ -0,0 +1,87 @@
+package rfc3339cbor
+
+import (
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/jsumners/go-rfc3339"
+)
+
+// FullDate encodes an [rfc3339.FullDate] as CBOR tag 1004, an RFC 3339
+// full-date text string.
+type FullDate struct {
+	rfc3339.FullDate
+}
+
+// EpochFullDate encodes an [rfc3339.FullDate] as CBOR tag 100, the number of
+// days since 1970-01-01.
+type EpochFullDate struct {
+	rfc3339.FullDate
+}
+
+// MarshalCBOR implements the [cbor.Marshaler] interface.
+func (fd FullDate) MarshalCBOR() ([]byte, error) {
+	if fd.IsZero() {
+		return cborNull, nil
+	}
+	return cbor.Marshal(cbor.Tag{Number: TagFullDateString, Content: fd.ToString()})
+}
+
+// UnmarshalCBOR implements the [cbor.Unmarshaler] interface.
+func (fd *FullDate) UnmarshalCBOR(data []byte) error {
+	parsed, err := decodeFullDate(data)
+	if err != nil {
+		return err
+	}
+	fd.FullDate = parsed
+	return nil
+}
+
+// MarshalCBOR implements the [cbor.Marshaler] interface.
+func (fd EpochFullDate) MarshalCBOR() ([]byte, error) {
+	if fd.IsZero() {
+		return cborNull, nil
+	}
+
+	return cbor.Marshal(cbor.Tag{Number: TagFullDateEpoch, Content: fd.EpochDays()})
+}
+
+// UnmarshalCBOR implements the [cbor.Unmarshaler] interface.
+func (fd *EpochFullDate) UnmarshalCBOR(data []byte) error {
+	parsed, err := decodeFullDate(data)
+	if err != nil {
+		return err
+	}
+	fd.FullDate = parsed
+	return nil
+}
+
+// decodeFullDate decodes any of the supported full-date tags.
+func decodeFullDate(data []byte) (rfc3339.FullDate, error) {
+	if isNull(data) {
+		return rfc3339.FullDate{}, nil
+	}
+
+	tag, err := decodeTag(data, "full-date", TagFullDateString, TagFullDateEpoch)
+	if err != nil {
+		return rfc3339.FullDate{}, err
+	}
+
+	if tag.Number == TagFullDateString {
+		var content string
+		if err := cbor.Unmarshal(tag.Content, &content); err != nil {
+			return rfc3339.FullDate{}, fmt.Errorf("CBOR tag 1004 content must be a text string: %w", err)
+		}
+		return rfc3339.NewFullDateFromString(content)
+	}
+
+	var days int64
+	if err := cbor.Unmarshal(tag.Content, &days); err != nil {
+		return rfc3339.FullDate{}, fmt.Errorf("CBOR tag 100 content must be an integer: %w", err)
+	}
+	fd, err := rfc3339.NewFullDateFromEpochDays(days)
+	if err != nil {
+		return rfc3339.FullDate{}, fmt.Errorf("CBOR tag 100 content out of range: %d", days)
+	}
+	return fd, nil
+}
@@ -0,0 +1,111 @@
+package rfc3339cbor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullDate(t *testing.T) {
+	t.Run("encodes RFC 8943 example", func(t *testing.T) {
+		input := FullDate{rfc3339.MustParseDateString("1940-10-09")}
+		data, err := cbor.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, "d903ec6a313934302d31302d3039", hex.EncodeToString(data))
+
+		var found FullDate
+		err = cbor.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("encodes zero value as null", func(t *testing.T) {
+		data, err := cbor.Marshal(FullDate{})
+		require.NoError(t, err)
+		assert.Equal(t, "f6", hex.EncodeToString(data))
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// 1004("1940/10/09")
+			{"d903ec6a313934302f31302f3039", "`1940/10/09` is not a full-date string"},
+			// 0("1940-10-09")
+			{"c06a313934302d31302d3039", "unsupported CBOR tag for full-date: 0"},
+		}
+
+		for _, test := range tests {
+			var found FullDate
+			err := cbor.Unmarshal(mustHex(t, test.input), &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
+
+func TestEpochFullDate(t *testing.T) {
+	t.Run("encodes RFC 8943 examples", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"1940-10-09", "d8643929b3"},
+			{"1980-12-08", "d864190f9a"},
+		}
+
+		for _, test := range tests {
+			input := EpochFullDate{rfc3339.MustParseDateString(test.input)}
+			data, err := cbor.Marshal(input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(data))
+
+			var found EpochFullDate
+			err = cbor.Unmarshal(data, &found)
+			require.NoError(t, err)
+			assert.Equal(t, input, found)
+		}
+	})
+
+	t.Run("round trips range limits", func(t *testing.T) {
+		for _, input := range []string{"0001-01-02", "9999-12-31"} {
+			expected := EpochFullDate{rfc3339.MustParseDateString(input)}
+			data, err := cbor.Marshal(expected)
+			require.NoError(t, err)
+
+			var found EpochFullDate
+			err = cbor.Unmarshal(data, &found)
+			require.NoError(t, err)
+			assert.Equal(t, expected, found)
+		}
+	})
+
+	t.Run("decodes the string tag", func(t *testing.T) {
+		var found EpochFullDate
+		err := cbor.Unmarshal(mustHex(t, "d903ec6a313934302d31302d3039"), &found)
+		require.NoError(t, err)
+		assert.Equal(t, "1940-10-09", found.ToString())
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// 100(2932897)
+			{"d8641a002cc0a1", "CBOR tag 100 content out of range: 2932897"},
+			// 100(1.5)
+			{"d864f93e00", "CBOR tag 100 content must be an integer"},
+		}
+
+		for _, test := range tests {
+			var found EpochFullDate
+			err := cbor.Unmarshal(mustHex(t, test.input), &found)
+			assert.ErrorContains(t, err, test.expected)
+		}
+	})
+}
@@ -0,0 +1,19 @@
+module github.com/jsumners/go-rfc3339/rfc3339cbor
+
+go 1.24
+
+require (
+	github.com/fxamacker/cbor/v2 v2.9.0
+	github.com/jsumners/go-rfc3339 v1.3.0
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jsumners/go-reggie v1.0.0-rc.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/samber/mo v1.11.0 // indirect
+	github.com/spf13/cast v1.6.0 // indirect
+	github.com/x448/float16 v0.8.4 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,134 @@
+package rfc3339csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ParseError reports a CSV cell that could not be converted to its field.
+// Row, Line, and Column are 1-based. Row counts records, with the header
+// being row 1, while Line is the line of the input on which the cell starts;
+// they differ once a quoted cell has spanned lines. Column is the index of
+// the cell within the record.
+type ParseError struct {
+	Row    int
+	Line   int
+	Column int
+	Name   string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("row %d (line %d), column %d (%s): %s", e.Row, e.Line, e.Column, e.Name, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Decoder reads CSV records into structs.
+type Decoder struct {
+	reader  *csv.Reader
+	header  []string
+	row     int
+	columns map[reflect.Type][]int
+}
+
+// NewDecoder creates a [Decoder] that reads from r. The first record read
+// from r is the header.
+func NewDecoder(r io.Reader) *Decoder {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	return &Decoder{
+		reader:  reader,
+		columns: map[reflect.Type][]int{},
+	}
+}
+
+// Reader provides access to the underlying [csv.Reader] so that it can be
+// configured, e.g. to set the field delimiter, before the first call to
+// [Decoder.Decode].
+func (d *Decoder) Reader() *csv.Reader {
+	return d.reader
+}
+
+// Header returns the column names, or nil if the header has not been read.
+func (d *Decoder) Header() []string {
+	return d.header
+}
+
+// Decode reads the next record into the struct pointed to by v. At the end
+// of the input it returns [io.EOF]. Cells that cannot be converted result in
+// a [*ParseError].
+func (d *Decoder) Decode(v any) error {
+	rv, err := structValue(v, true)
+	if err != nil {
+		return err
+	}
+
+	if d.header == nil {
+		record, err := d.read()
+		if err != nil {
+			return err
+		}
+		d.header = append([]string(nil), record...)
+	}
+
+	record, err := d.read()
+	if err != nil {
+		return err
+	}
+
+	fields := structFields(rv.Type())
+	for i, column := range d.fieldColumns(rv.Type(), fields) {
+		if column < 0 || column >= len(record) {
+			continue
+		}
+		if err := parseValue(record[column], rv.FieldByIndex(fields[i].index)); err != nil {
+			line, _ := d.reader.FieldPos(column)
+			return &ParseError{Row: d.row, Line: line, Column: column + 1, Name: d.header[column], Err: err}
+		}
+	}
+
+	return nil
+}
+
+// read reads the next record, and tracks the row number.
+func (d *Decoder) read() ([]string, error) {
+	record, err := d.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	d.row += 1
+	return record, nil
+}
+
+// fieldColumns maps each field of the struct type to the index of its
+// column, or -1 if there is no column for the field.
+func (d *Decoder) fieldColumns(typ reflect.Type, fields []field) []int {
+	if columns, ok := d.columns[typ]; ok {
+		return columns
+	}
+
+	byName := make(map[string]int, len(d.header))
+	for i, name := range d.header {
+		if _, ok := byName[name]; !ok {
+			byName[name] = i
+		}
+	}
+
+	columns := make([]int, len(fields))
+	for i, f := range fields {
+		column, ok := byName[f.name]
+		if !ok {
+			column = -1
+		}
+		columns[i] = column
+	}
+	d.columns[typ] = columns
+
+	return columns
+}
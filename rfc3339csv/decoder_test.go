@@ -0,0 +1,185 @@
+package rfc3339csv
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type record struct {
+	ID       int                 `csv:"id"`
+	Name     string              `csv:"name"`
+	Created  rfc3339.DateTime    `csv:"created"`
+	Due      rfc3339.FullDate    `csv:"due"`
+	Reminder rfc3339.PartialTime `csv:"reminder"`
+	Score    float64             `csv:"score"`
+	Active   bool                `csv:"active"`
+	Ignored  string              `csv:"-"`
+	Note     string
+}
+
+func TestDecoder(t *testing.T) {
+	t.Run("decodes records by header name", func(t *testing.T) {
+		input := strings.Join([]string{
+			"name,id,created,due,reminder,score,active,Note,extra",
+			"first,1,2023-04-01T12:30:00-04:00,2023-04-10,09:00:00,1.5,true,hello,x",
+			"second,2,,,,0,false,,y",
+		}, "\n")
+		decoder := NewDecoder(strings.NewReader(input))
+
+		var found record
+		err := decoder.Decode(&found)
+		require.NoError(t, err)
+		assert.Equal(t, record{
+			ID:       1,
+			Name:     "first",
+			Created:  rfc3339.MustParseDateTimeString("2023-04-01T12:30:00-04:00"),
+			Due:      rfc3339.MustParseDateString("2023-04-10"),
+			Reminder: rfc3339.MustParsePartialTimeString("09:00:00"),
+			Score:    1.5,
+			Active:   true,
+			Note:     "hello",
+		}, found)
+		assert.Equal(t, []string{"name", "id", "created", "due", "reminder", "score", "active", "Note", "extra"}, decoder.Header())
+
+		found = record{}
+		err = decoder.Decode(&found)
+		require.NoError(t, err)
+		assert.Equal(t, record{ID: 2, Name: "second"}, found)
+		assert.True(t, found.Created.IsZero())
+		assert.True(t, found.Due.IsZero())
+
+		err = decoder.Decode(&found)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("leaves fields without a column untouched", func(t *testing.T) {
+		decoder := NewDecoder(strings.NewReader("id\n7\n"))
+		found := record{Name: "kept"}
+		err := decoder.Decode(&found)
+		require.NoError(t, err)
+		assert.Equal(t, record{ID: 7, Name: "kept"}, found)
+	})
+
+	t.Run("reports row and column of invalid cells", func(t *testing.T) {
+		input := strings.Join([]string{
+			"id,created,due",
+			"1,2023-04-01T12:30:00Z,2023-04-10",
+			"2,2023-04-01 12:30:00Z,2023-04-10",
+		}, "\n")
+		decoder := NewDecoder(strings.NewReader(input))
+
+		var found record
+		require.NoError(t, decoder.Decode(&found))
+
+		err := decoder.Decode(&found)
+		var parseErr *ParseError
+		require.True(t, errors.As(err, &parseErr))
+		assert.Equal(t, 3, parseErr.Row)
+		assert.Equal(t, 3, parseErr.Line)
+		assert.Equal(t, 2, parseErr.Column)
+		assert.Equal(t, "created", parseErr.Name)
+		assert.ErrorContains(t, err, "row 3 (line 3), column 2 (created): ")
+	})
+
+	t.Run("reports lines separately from rows", func(t *testing.T) {
+		input := strings.Join([]string{
+			"name,created",
+			`"two`,
+			`lines",2023-04-01T12:30:00Z`,
+			"bad,2023-04-01",
+		}, "\n")
+		decoder := NewDecoder(strings.NewReader(input))
+
+		var found record
+		require.NoError(t, decoder.Decode(&found))
+		assert.Equal(t, "two\nlines", found.Name)
+
+		err := decoder.Decode(&found)
+		var parseErr *ParseError
+		require.True(t, errors.As(err, &parseErr))
+		assert.Equal(t, 3, parseErr.Row)
+		assert.Equal(t, 4, parseErr.Line)
+		assert.ErrorContains(t, err, "row 3 (line 4), column 2 (created): ")
+	})
+
+	t.Run("reads empty cells as zero values", func(t *testing.T) {
+		type values struct {
+			ID       int                 `csv:"id"`
+			Count    uint                `csv:"count"`
+			Name     string              `csv:"name"`
+			Created  rfc3339.DateTime    `csv:"created"`
+			Due      rfc3339.FullDate    `csv:"due"`
+			Reminder rfc3339.PartialTime `csv:"reminder"`
+			Score    float64             `csv:"score"`
+			Active   bool                `csv:"active"`
+		}
+		decoder := NewDecoder(strings.NewReader("id,count,name,created,due,reminder,score,active\n,,,,,,,\n"))
+
+		found := values{
+			ID:       1,
+			Count:    3,
+			Name:     "first",
+			Created:  rfc3339.MustParseDateTimeString("2023-04-01T12:30:00-04:00"),
+			Due:      rfc3339.MustParseDateString("2023-04-10"),
+			Reminder: rfc3339.MustParsePartialTimeString("09:00:00"),
+			Score:    1.5,
+			Active:   true,
+		}
+		err := decoder.Decode(&found)
+		require.NoError(t, err)
+		assert.Equal(t, values{}, found)
+	})
+
+	t.Run("decodes pointer fields", func(t *testing.T) {
+		type optional struct {
+			Created *rfc3339.DateTime `csv:"created"`
+			Due     *rfc3339.FullDate `csv:"due"`
+			Score   *float64          `csv:"score"`
+		}
+		decoder := NewDecoder(strings.NewReader("created,due,score\n2023-04-01T12:30:00Z,,1.5\n"))
+
+		found := optional{Due: &rfc3339.FullDate{}}
+		err := decoder.Decode(&found)
+		require.NoError(t, err)
+		require.NotNil(t, found.Created)
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-01T12:30:00Z"), *found.Created)
+		assert.Nil(t, found.Due)
+		require.NotNil(t, found.Score)
+		assert.Equal(t, 1.5, *found.Score)
+	})
+
+	t.Run("rejects a date-time in a full-date column", func(t *testing.T) {
+		decoder := NewDecoder(strings.NewReader("due\n2023-04-10T00:00:00Z\n"))
+		var found record
+		err := decoder.Decode(&found)
+		assert.ErrorContains(t, err, "row 2 (line 2), column 1 (due): ")
+	})
+
+	t.Run("reports invalid basic values", func(t *testing.T) {
+		decoder := NewDecoder(strings.NewReader("id,active\n1,maybe\n"))
+		var found record
+		err := decoder.Decode(&found)
+		assert.ErrorContains(t, err, "row 2 (line 2), column 2 (active): ")
+	})
+
+	t.Run("uses the configured reader", func(t *testing.T) {
+		decoder := NewDecoder(strings.NewReader("id;due\n1;2023-04-10\n"))
+		decoder.Reader().Comma = ';'
+		var found record
+		err := decoder.Decode(&found)
+		require.NoError(t, err)
+		assert.Equal(t, rfc3339.MustParseDateString("2023-04-10"), found.Due)
+	})
+
+	t.Run("requires a pointer to a struct", func(t *testing.T) {
+		decoder := NewDecoder(strings.NewReader("id\n1\n"))
+		err := decoder.Decode(record{})
+		assert.ErrorContains(t, err, "value must be a non-nil pointer to a struct, got: rfc3339csv.record")
+	})
+}
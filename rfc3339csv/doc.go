@@ -0,0 +1,15 @@
+// Package rfc3339csv reads and writes CSV records as structs, converting
+// [rfc3339.DateTime], [rfc3339.FullDate], and [rfc3339.PartialTime] fields
+// with the rfc3339 parsers and their ToString methods. Records are streamed
+// through [encoding/csv] one at a time.
+//
+// The first record of the input is a header that names the columns. Struct
+// fields are matched to columns by the name in their `csv` struct tag, or
+// by the field name when there is no tag. Fields tagged `csv:"-"` are
+// ignored, as are columns without a matching field. Supported field types
+// are strings, booleans, integers, floating-point numbers, the rfc3339 types,
+// and any type that implements [encoding.TextUnmarshaler] and
+// [encoding.TextMarshaler], as well as pointers to any of them. Zero rfc3339
+// values and nil pointers are written as empty cells. Empty cells are read as
+// zero values, or as nil for pointer fields.
+package rfc3339csv
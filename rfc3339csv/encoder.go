@@ -0,0 +1,66 @@
+package rfc3339csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// Encoder writes structs as CSV records.
+type Encoder struct {
+	writer      *csv.Writer
+	wroteHeader bool
+	record      []string
+}
+
+// NewEncoder creates an [Encoder] that writes to w. The header is written
+// before the first record, using the field names of the first struct.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{writer: csv.NewWriter(w)}
+}
+
+// Writer provides access to the underlying [csv.Writer] so that it can be
+// configured, e.g. to set the field delimiter, before the first call to
+// [Encoder.Encode].
+func (e *Encoder) Writer() *csv.Writer {
+	return e.writer
+}
+
+// Encode writes the struct, or pointer to a struct, v as a record. Records
+// are buffered; call [Encoder.Flush] once all records have been encoded.
+func (e *Encoder) Encode(v any) error {
+	rv, err := structValue(v, false)
+	if err != nil {
+		return err
+	}
+
+	fields := structFields(rv.Type())
+	if !e.wroteHeader {
+		header := make([]string, len(fields))
+		for i, f := range fields {
+			header[i] = f.name
+		}
+		if err := e.writer.Write(header); err != nil {
+			return err
+		}
+		e.wroteHeader = true
+	}
+
+	e.record = e.record[:0]
+	for _, f := range fields {
+		cell, err := formatValue(rv.FieldByIndex(f.index))
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+		e.record = append(e.record, cell)
+	}
+
+	return e.writer.Write(e.record)
+}
+
+// Flush writes any buffered records to the underlying writer, and returns
+// any error that occurred while writing.
+func (e *Encoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
@@ -0,0 +1,103 @@
+package rfc3339csv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Run("writes a header and records", func(t *testing.T) {
+		var output strings.Builder
+		encoder := NewEncoder(&output)
+
+		err := encoder.Encode(record{
+			ID:       1,
+			Name:     "first",
+			Created:  rfc3339.MustParseDateTimeString("2023-04-01T12:30:00.5-04:00"),
+			Due:      rfc3339.MustParseDateString("2023-04-10"),
+			Reminder: rfc3339.MustParsePartialTimeString("09:00:00"),
+			Score:    1.5,
+			Active:   true,
+			Ignored:  "ignored",
+			Note:     "a, b",
+		})
+		require.NoError(t, err)
+		err = encoder.Encode(&record{ID: 2})
+		require.NoError(t, err)
+		require.NoError(t, encoder.Flush())
+
+		expected := strings.Join([]string{
+			"id,name,created,due,reminder,score,active,Note",
+			`1,first,2023-04-01T12:30:00.5-04:00,2023-04-10,09:00:00,1.5,true,"a, b"`,
+			"2,,,,,0,false,",
+			"",
+		}, "\n")
+		assert.Equal(t, expected, output.String())
+	})
+
+	t.Run("round trips through the decoder", func(t *testing.T) {
+		input := []record{
+			{ID: 1, Created: rfc3339.MustParseDateTimeString("2023-04-01T12:30:00Z"), Due: rfc3339.MustParseDateString("2023-04-10")},
+			{ID: 2, Created: rfc3339.MustParseDateTimeString("2023-04-02T01:02:03.123456789+05:30")},
+		}
+
+		var output strings.Builder
+		encoder := NewEncoder(&output)
+		for _, r := range input {
+			require.NoError(t, encoder.Encode(r))
+		}
+		require.NoError(t, encoder.Flush())
+
+		decoder := NewDecoder(strings.NewReader(output.String()))
+		for _, expected := range input {
+			var found record
+			require.NoError(t, decoder.Decode(&found))
+			assert.Equal(t, expected.ID, found.ID)
+			assert.Equal(t, expected.Created.ToString(), found.Created.ToString())
+			assert.Equal(t, expected.Due.IsZero(), found.Due.IsZero())
+			if !expected.Due.IsZero() {
+				assert.Equal(t, expected.Due.ToString(), found.Due.ToString())
+			}
+		}
+	})
+
+	t.Run("writes pointer fields", func(t *testing.T) {
+		type optional struct {
+			Created *rfc3339.DateTime `csv:"created"`
+			Due     *rfc3339.FullDate `csv:"due"`
+			Score   *float64          `csv:"score"`
+		}
+		created := rfc3339.MustParseDateTimeString("2023-04-01T12:30:00Z")
+		score := 1.5
+
+		var output strings.Builder
+		encoder := NewEncoder(&output)
+		require.NoError(t, encoder.Encode(optional{Created: &created, Score: &score}))
+		require.NoError(t, encoder.Encode(optional{}))
+		require.NoError(t, encoder.Flush())
+
+		expected := strings.Join([]string{
+			"created,due,score",
+			"2023-04-01T12:30:00Z,,1.5",
+			",,",
+			"",
+		}, "\n")
+		assert.Equal(t, expected, output.String())
+	})
+
+	t.Run("requires a struct", func(t *testing.T) {
+		encoder := NewEncoder(&strings.Builder{})
+		err := encoder.Encode(42)
+		assert.ErrorContains(t, err, "value must be a struct, got: int")
+	})
+
+	t.Run("reports unsupported field types", func(t *testing.T) {
+		encoder := NewEncoder(&strings.Builder{})
+		err := encoder.Encode(struct{ Tags []string }{})
+		assert.ErrorContains(t, err, "field Tags: unsupported field type: []string")
+	})
+}
@@ -0,0 +1,156 @@
+package rfc3339csv
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	rfc3339Type         = reflect.TypeOf((*rfc3339.RFC3339)(nil)).Elem()
+)
+
+// field describes a struct field that maps to a CSV column.
+type field struct {
+	name  string
+	index []int
+}
+
+// structFields lists the fields of a struct type that map to CSV columns.
+func structFields(typ reflect.Type) []field {
+	var fields []field
+	for i := 0; i < typ.NumField(); i += 1 {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := sf.Name
+		if tag, ok := sf.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		fields = append(fields, field{name: name, index: sf.Index})
+	}
+	return fields
+}
+
+// structValue verifies v is a struct, or a pointer to a struct, and returns
+// the struct value.
+func structValue(v any, pointer bool) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if pointer {
+		if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("value must be a non-nil pointer to a struct, got: %T", v)
+		}
+		return rv.Elem(), nil
+	}
+
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("value must be a struct, got: %T", v)
+	}
+	return rv, nil
+}
+
+// formatValue converts a field value to a CSV cell. Nil pointers are
+// written as empty cells.
+func formatValue(rv reflect.Value) (string, error) {
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", nil
+		}
+		return formatValue(rv.Elem())
+	}
+	if rv.Type().Implements(rfc3339Type) {
+		if zero, ok := rv.Interface().(interface{ IsZero() bool }); ok && zero.IsZero() {
+			return "", nil
+		}
+		return rv.Interface().(rfc3339.RFC3339).ToString(), nil
+	}
+	if rv.Type().Implements(textMarshalerType) {
+		text, err := rv.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	default:
+		return "", fmt.Errorf("unsupported field type: %s", rv.Type())
+	}
+}
+
+// parseValue converts a CSV cell to a field value. Empty cells set the
+// field to its zero value, which is nil for pointer fields. Otherwise, nil
+// pointer fields are set to a newly allocated value.
+func parseValue(cell string, rv reflect.Value) error {
+	if cell == "" {
+		rv.SetZero()
+		return nil
+	}
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return parseValue(cell, rv.Elem())
+	}
+	if rv.Addr().Type().Implements(textUnmarshalerType) {
+		return rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(cell))
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(cell)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(cell))
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(strings.TrimSpace(cell), 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(strings.TrimSpace(cell), 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(cell), rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type: %s", rv.Type())
+	}
+
+	return nil
+}
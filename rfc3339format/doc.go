@@ -0,0 +1,21 @@
+// Package rfc3339format provides validators for the JSON Schema and
+// OpenAPI `format` keywords that are defined by reference to RFC 3339:
+//
+//   - `date-time`, an RFC 3339 date-time, e.g. 2023-04-04T12:30:00-04:00.
+//   - `date`, an RFC 3339 full-date, e.g. 2023-04-04.
+//   - `time`, an RFC 3339 full-time, e.g. 12:30:00-04:00.
+//   - `duration`, an ISO 8601 duration as defined in appendix A of RFC 3339,
+//     e.g. P1DT12H.
+//
+// The validators are plain `func(string) error` functions, available
+// individually and through [Validators] and [Lookup]. They are built on the
+// rfc3339 package's validators, which enforce the field ranges of RFC 3339
+// section 5.7, such as the number of days in a month and leap seconds only
+// at 23:59 UTC. This makes them stricter than the parsers, which normalize
+// out of range fields.
+//
+// [RegisterJSONSchema] registers the validators with a
+// github.com/santhosh-tekuri/jsonschema/v6 compiler. [RegisterOpenAPI] and
+// [OpenAPIOption] register them with github.com/getkin/kin-openapi request
+// and schema validation.
+package rfc3339format
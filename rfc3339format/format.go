@@ -0,0 +1,61 @@
+package rfc3339format
+
+import (
+	"fmt"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+// Format names, as used by the `format` keyword.
+const (
+	FormatDateTime = "date-time"
+	FormatDate     = "date"
+	FormatTime     = "time"
+	FormatDuration = "duration"
+)
+
+// ValidateDateTime verifies the input is an RFC 3339 `date-time` string
+// whose fields are within the ranges of RFC 3339 section 5.7. It is
+// [rfc3339.ValidateDateTimeString].
+func ValidateDateTime(input string) error {
+	return rfc3339.ValidateDateTimeString(input)
+}
+
+// ValidateDate verifies the input is an RFC 3339 `full-date` string whose
+// fields are within the ranges of RFC 3339 section 5.7. It is
+// [rfc3339.ValidateFullDateString].
+func ValidateDate(input string) error {
+	return rfc3339.ValidateFullDateString(input)
+}
+
+// ValidateTime verifies the input is an RFC 3339 `full-time` string whose
+// fields are within the ranges of RFC 3339 section 5.7. It is
+// [rfc3339.ValidateFullTimeString].
+func ValidateTime(input string) error {
+	return rfc3339.ValidateFullTimeString(input)
+}
+
+// ValidateDuration verifies the input is an RFC 3339 `duration` string.
+func ValidateDuration(input string) error {
+	if !rfc3339.IsDurationString(input) {
+		return fmt.Errorf("`%s` is not a duration string", input)
+	}
+	return nil
+}
+
+// Validators returns the validators keyed by their format name. A new map
+// is returned on each invocation, so it can be modified freely.
+func Validators() map[string]func(string) error {
+	return map[string]func(string) error{
+		FormatDateTime: ValidateDateTime,
+		FormatDate:     ValidateDate,
+		FormatTime:     ValidateTime,
+		FormatDuration: ValidateDuration,
+	}
+}
+
+// Lookup returns the validator for the named format, if there is one.
+func Lookup(name string) (func(string) error, bool) {
+	validate, ok := Validators()[name]
+	return validate, ok
+}
@@ -0,0 +1,79 @@
+package rfc3339format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		err    string
+	}{
+		{format: FormatDateTime, input: "2023-04-04T12:30:00-04:00"},
+		{format: FormatDateTime, input: "2023-04-04t12:30:00.5z"},
+		{format: FormatDateTime, input: "2023-04-04", err: "input is not a date-time string: 2023-04-04"},
+		{format: FormatDateTime, input: "2023-04-04T12:30:00", err: "input is not a date-time string: 2023-04-04T12:30:00"},
+		{format: FormatDateTime, input: "2023-02-30T12:30:00Z", err: "`2023-02-30T12:30:00Z` is not a valid date-time: day out of range"},
+		{format: FormatDateTime, input: "2023-13-01T12:30:00Z", err: "`2023-13-01T12:30:00Z` is not a valid date-time: month out of range"},
+		{format: FormatDateTime, input: "2023-04-04T24:00:00Z", err: "`2023-04-04T24:00:00Z` is not a valid date-time: hour out of range"},
+		{format: FormatDateTime, input: "2023-04-04T12:30:00+99:99", err: "`2023-04-04T12:30:00+99:99` is not a valid date-time: offset hour out of range"},
+		{format: FormatDateTime, input: "2016-12-31T23:59:60Z"},
+		{format: FormatDateTime, input: "2016-12-31T18:59:60-05:00"},
+		{format: FormatDateTime, input: "2016-12-31T12:30:60Z", err: "`2016-12-31T12:30:60Z` is not a valid date-time: second out of range"},
+		{format: FormatDate, input: "2023-04-04"},
+		{format: FormatDate, input: "2024-02-29"},
+		{format: FormatDate, input: "2023-4-4", err: "`2023-4-4` is not a full-date string"},
+		{format: FormatDate, input: "2023-02-29", err: "`2023-02-29` is not a valid full-date: day out of range"},
+		{format: FormatDate, input: "2023-02-30", err: "`2023-02-30` is not a valid full-date: day out of range"},
+		{format: FormatDate, input: "2023-13-01", err: "`2023-13-01` is not a valid full-date: month out of range"},
+		{format: FormatDate, input: "2023-00-01", err: "`2023-00-01` is not a valid full-date: month out of range"},
+		{format: FormatDate, input: "2023-04-00", err: "`2023-04-00` is not a valid full-date: day out of range"},
+		{format: FormatTime, input: "12:30:00Z"},
+		{format: FormatTime, input: "12:30:00.123+05:30"},
+		{format: FormatTime, input: "12:30:00", err: "`12:30:00` is not a full-time string"},
+		{format: FormatTime, input: "24:00:00Z", err: "`24:00:00Z` is not a valid full-time: hour out of range"},
+		{format: FormatTime, input: "12:60:00Z", err: "`12:60:00Z` is not a valid full-time: minute out of range"},
+		{format: FormatTime, input: "12:30:61Z", err: "`12:30:61Z` is not a valid full-time: second out of range"},
+		{format: FormatTime, input: "12:30:00+99:99", err: "`12:30:00+99:99` is not a valid full-time: offset hour out of range"},
+		{format: FormatTime, input: "12:30:00+05:60", err: "`12:30:00+05:60` is not a valid full-time: offset minute out of range"},
+		{format: FormatTime, input: "23:59:60Z"},
+		{format: FormatDuration, input: "P1DT12H"},
+		{format: FormatDuration, input: "P2W"},
+		{format: FormatDuration, input: "PT", err: "`PT` is not a duration string"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format+" "+test.input, func(t *testing.T) {
+			validate, ok := Lookup(test.format)
+			require.True(t, ok)
+
+			err := validate(test.input)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+
+	t.Run("lists all formats", func(t *testing.T) {
+		validators := Validators()
+		assert.Len(t, validators, 4)
+		for _, name := range []string{"date-time", "date", "time", "duration"} {
+			assert.Contains(t, validators, name)
+		}
+
+		delete(validators, FormatDate)
+		_, ok := Lookup(FormatDate)
+		assert.True(t, ok)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, ok := Lookup("email")
+		assert.False(t, ok)
+	})
+}
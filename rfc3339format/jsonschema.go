@@ -0,0 +1,25 @@
+package rfc3339format
+
+import (
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// RegisterJSONSchema registers the validators with the compiler, replacing
+// its built-in validators for the same formats. Values that are not strings
+// are not validated, as required by the JSON Schema specification. Note
+// that, depending on the draft, format assertions may need to be enabled
+// with [jsonschema.Compiler.AssertFormat].
+func RegisterJSONSchema(c *jsonschema.Compiler) {
+	for name, validate := range Validators() {
+		c.RegisterFormat(&jsonschema.Format{
+			Name: name,
+			Validate: func(v any) error {
+				s, ok := v.(string)
+				if !ok {
+					return nil
+				}
+				return validate(s)
+			},
+		})
+	}
+}
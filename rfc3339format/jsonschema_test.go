@@ -0,0 +1,73 @@
+package rfc3339format
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterJSONSchema(t *testing.T) {
+	schemaJSON := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"created": {"format": "date-time"},
+			"due": {"type": "string", "format": "date"},
+			"opens": {"type": "string", "format": "time"},
+			"ttl": {"type": "string", "format": "duration"}
+		}
+	}`
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(schemaJSON))
+	require.NoError(t, err)
+
+	c := jsonschema.NewCompiler()
+	c.AssertFormat()
+	RegisterJSONSchema(c)
+	require.NoError(t, c.AddResource("schema.json", doc))
+	schema, err := c.Compile("schema.json")
+	require.NoError(t, err)
+
+	t.Run("accepts valid values", func(t *testing.T) {
+		instance := map[string]any{
+			"created": "2023-04-04t12:30:00z",
+			"due":     "2023-04-04",
+			"opens":   "09:00:00-04:00",
+			"ttl":     "PT1H30M",
+		}
+		assert.NoError(t, schema.Validate(instance))
+	})
+
+	t.Run("ignores non-string values", func(t *testing.T) {
+		assert.NoError(t, schema.Validate(map[string]any{"created": 42}))
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		tests := []struct {
+			property string
+			input    string
+			message  string
+		}{
+			{property: "created", input: "2023-04-04 12:30:00Z", message: "input is not a date-time string: 2023-04-04 12:30:00Z"},
+			{property: "due", input: "2023-04-04T00:00:00Z", message: "`2023-04-04T00:00:00Z` is not a full-date string"},
+			{property: "opens", input: "09:00:00", message: "`09:00:00` is not a full-time string"},
+			{property: "ttl", input: "P1H", message: "`P1H` is not a duration string"},
+			{property: "created", input: "2023-02-30T12:30:00Z", message: "day out of range"},
+			{property: "created", input: "2023-04-04T24:00:00Z", message: "hour out of range"},
+			{property: "created", input: "2023-04-04T12:30:00+99:99", message: "offset hour out of range"},
+			{property: "due", input: "2023-02-30", message: "day out of range"},
+			{property: "due", input: "2023-13-01", message: "month out of range"},
+			{property: "opens", input: "24:00:00Z", message: "hour out of range"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.property+" "+test.input, func(t *testing.T) {
+				err := schema.Validate(map[string]any{test.property: test.input})
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.message)
+			})
+		}
+	})
+}
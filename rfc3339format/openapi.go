@@ -0,0 +1,26 @@
+package rfc3339format
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// RegisterOpenAPI registers the validators as kin-openapi's global string
+// format validators, via [openapi3.DefineStringFormatValidator]. This
+// affects all schema and request validation in the process.
+func RegisterOpenAPI() {
+	for name, validate := range Validators() {
+		openapi3.DefineStringFormatValidator(name, openapi3.NewCallbackValidator(validate))
+	}
+}
+
+// OpenAPIOption provides the validators as a kin-openapi schema validation
+// option, for use with a single validation instead of registering them
+// globally. They take precedence over any global validators for the same
+// formats.
+func OpenAPIOption() openapi3.SchemaValidationOption {
+	validators := map[string]openapi3.StringFormatValidator{}
+	for name, validate := range Validators() {
+		validators[name] = openapi3.NewCallbackValidator(validate)
+	}
+	return openapi3.WithStringFormatValidators(validators)
+}
@@ -0,0 +1,108 @@
+package rfc3339format
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const openAPISpec = `
+openapi: 3.0.3
+info:
+  title: events
+  version: 1.0.0
+paths:
+  /events:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                created:
+                  type: string
+                  format: date-time
+                ttl:
+                  type: string
+                  format: duration
+      responses:
+        "204":
+          description: created
+`
+
+func validateRequest(t *testing.T, body string, options *openapi3filter.Options) error {
+	t.Helper()
+
+	ctx := context.Background()
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(openAPISpec))
+	require.NoError(t, err)
+	require.NoError(t, doc.Validate(ctx))
+	router, err := gorillamux.NewRouter(doc)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	route, pathParams, err := router.FindRoute(req)
+	require.NoError(t, err)
+
+	return openapi3filter.ValidateRequest(ctx, &openapi3filter.RequestValidationInput{
+		Request:    req,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    options,
+	})
+}
+
+func TestOpenAPIOption(t *testing.T) {
+	body := `{"created":"2023-04-04t12:30:00z","ttl":"P1D"}`
+
+	t.Run("differs from the default validators", func(t *testing.T) {
+		err := validateRequest(t, body, nil)
+		assert.ErrorContains(t, err, `string doesn't match the format "date-time"`)
+	})
+
+	options := &openapi3filter.Options{
+		SchemaValidationOptions: []openapi3.SchemaValidationOption{OpenAPIOption()},
+	}
+
+	t.Run("accepts valid values", func(t *testing.T) {
+		assert.NoError(t, validateRequest(t, body, options))
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		err := validateRequest(t, `{"created":"2023-04-04T12:30:00","ttl":"P1D"}`, options)
+		assert.ErrorContains(t, err, "input is not a date-time string: 2023-04-04T12:30:00")
+
+		err = validateRequest(t, `{"created":"2023-04-04T12:30:00Z","ttl":"1D"}`, options)
+		assert.ErrorContains(t, err, "`1D` is not a duration string")
+	})
+}
+
+func TestRegisterOpenAPI(t *testing.T) {
+	original := map[string]openapi3.StringFormatValidator{}
+	for name, validator := range openapi3.SchemaStringFormats {
+		original[name] = validator
+	}
+	t.Cleanup(func() {
+		openapi3.SchemaStringFormats = original
+	})
+
+	RegisterOpenAPI()
+
+	schema := openapi3.NewStringSchema().WithFormat("time")
+	assert.NoError(t, schema.VisitJSON("12:30:00Z"))
+	assert.ErrorContains(t, schema.VisitJSON("12:30:00"), "`12:30:00` is not a full-time string")
+
+	schema = openapi3.NewStringSchema().WithFormat("date-time")
+	assert.NoError(t, schema.VisitJSON("2023-04-04t12:30:00z"))
+}
@@ -0,0 +1,156 @@
+package rfc3339graphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jsumners/go-rfc3339"
+)
+
+// DateTimeScalar is the graphql-go definition of the `DateTime` scalar.
+// Parsed values are [rfc3339.DateTime] instances.
+var DateTimeScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "DateTime",
+	Description: "A date-time string, such as 2007-12-03T10:15:30Z or 2007-12-03T10:15:30+01:00, compliant with the `date-time` format outlined in section 5.6 of the RFC 3339 profile of the ISO 8601 standard for representation of dates and times using the Gregorian calendar.",
+	Serialize: func(value any) any {
+		serialized, err := SerializeDateTime(value)
+		if err != nil {
+			return nil
+		}
+		return serialized
+	},
+	ParseValue: func(value any) any {
+		dt, err := ParseDateTime(value)
+		if err != nil {
+			return nil
+		}
+		return dt
+	},
+	ParseLiteral: func(valueAST ast.Value) any {
+		dt, err := ParseDateTimeLiteral(valueAST)
+		if err != nil {
+			return nil
+		}
+		return dt
+	},
+})
+
+// DateTime implements the gqlgen marshaling methods for the `DateTime`
+// scalar.
+type DateTime struct {
+	rfc3339.DateTime
+}
+
+// MarshalGQL implements the gqlgen `graphql.Marshaler` interface.
+func (dt DateTime) MarshalGQL(w io.Writer) {
+	if dt.IsZero() {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.Quote(dt.ToString()))
+}
+
+// UnmarshalGQL implements the gqlgen `graphql.Unmarshaler` interface.
+func (dt *DateTime) UnmarshalGQL(v any) error {
+	if v == nil {
+		dt.DateTime = rfc3339.DateTime{}
+		return nil
+	}
+
+	parsed, err := ParseDateTime(v)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = parsed
+
+	return nil
+}
+
+// SerializeDateTime converts a result value to the `DateTime` scalar's
+// serialized form. Supported values are [rfc3339.DateTime], [DateTime],
+// [time.Time], pointers to them, and date-time strings. Nil pointers and
+// zero values serialize to nil.
+func SerializeDateTime(value any) (any, error) {
+	var dt rfc3339.DateTime
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case rfc3339.DateTime:
+		dt = v
+	case *rfc3339.DateTime:
+		if v == nil {
+			return nil, nil
+		}
+		dt = *v
+	case DateTime:
+		dt = v.DateTime
+	case *DateTime:
+		if v == nil {
+			return nil, nil
+		}
+		dt = v.DateTime
+	case time.Time:
+		dt = rfc3339.NewFromTime(v)
+	case *time.Time:
+		if v == nil {
+			return nil, nil
+		}
+		dt = rfc3339.NewFromTime(*v)
+	case string:
+		parsed, err := rfc3339.NewDateTimeFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("DateTime cannot represent an invalid date-time-string %s.", v)
+		}
+		dt = parsed
+	default:
+		return nil, fmt.Errorf("DateTime cannot be serialized from a non string, non numeric or non Date type %s", stringify(value))
+	}
+
+	if dt.IsZero() {
+		return nil, nil
+	}
+	return dt.ToString(), nil
+}
+
+// ParseDateTime parses an input value, e.g. a variable, of the `DateTime`
+// scalar. Strings must be RFC 3339 date-time strings; [rfc3339.DateTime]
+// and [time.Time] values are accepted as is.
+func ParseDateTime(value any) (rfc3339.DateTime, error) {
+	switch v := value.(type) {
+	case string:
+		dt, err := rfc3339.NewDateTimeFromString(v)
+		if err != nil {
+			return rfc3339.DateTime{}, fmt.Errorf("DateTime cannot represent an invalid date-time-string %s.", v)
+		}
+		return dt, nil
+	case rfc3339.DateTime:
+		return v, nil
+	case time.Time:
+		return rfc3339.NewFromTime(v), nil
+	default:
+		return rfc3339.DateTime{}, fmt.Errorf("DateTime cannot represent non string or Date type %s", stringify(value))
+	}
+}
+
+// ParseDateTimeLiteral parses an inline query literal of the `DateTime`
+// scalar, which must be a string.
+func ParseDateTimeLiteral(valueAST ast.Value) (rfc3339.DateTime, error) {
+	str, ok := valueAST.(*ast.StringValue)
+	if !ok {
+		return rfc3339.DateTime{}, fmt.Errorf("DateTime cannot represent non string or Date type %v", literalValue(valueAST))
+	}
+	return ParseDateTime(str.Value)
+}
+
+// literalValue returns the value of a query literal for use in error
+// messages.
+func literalValue(valueAST ast.Value) any {
+	if valueAST == nil {
+		return nil
+	}
+	return valueAST.GetValue()
+}
@@ -0,0 +1,154 @@
+package rfc3339graphql
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateTime_gqlgen(t *testing.T) {
+	t.Run("marshals quoted strings", func(t *testing.T) {
+		var buf bytes.Buffer
+		DateTime{rfc3339.MustParseDateTimeString("2023-04-04T12:30:00.5-04:00")}.MarshalGQL(&buf)
+		assert.Equal(t, `"2023-04-04T12:30:00.5-04:00"`, buf.String())
+	})
+
+	t.Run("marshals zero as null", func(t *testing.T) {
+		var buf bytes.Buffer
+		DateTime{}.MarshalGQL(&buf)
+		assert.Equal(t, "null", buf.String())
+	})
+
+	t.Run("unmarshals strings", func(t *testing.T) {
+		var found DateTime
+		require.NoError(t, found.UnmarshalGQL("2023-04-04T12:30:00Z"))
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-04T12:30:00Z"), found.DateTime)
+
+		require.NoError(t, found.UnmarshalGQL(nil))
+		assert.True(t, found.IsZero())
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		var found DateTime
+		err := found.UnmarshalGQL("2023-04-04")
+		assert.EqualError(t, err, "DateTime cannot represent an invalid date-time-string 2023-04-04.")
+
+		err = found.UnmarshalGQL(float64(1680611400))
+		assert.EqualError(t, err, "DateTime cannot represent non string or Date type 1680611400")
+
+		err = found.UnmarshalGQL(map[string]any{"a": 1})
+		assert.EqualError(t, err, `DateTime cannot represent non string or Date type {"a":1}`)
+	})
+}
+
+func TestSerializeDateTime(t *testing.T) {
+	dt := rfc3339.MustParseDateTimeString("2023-04-04T12:30:00-04:00")
+	var nilDateTime *rfc3339.DateTime
+
+	tests := []struct {
+		name     string
+		input    any
+		expected any
+	}{
+		{name: "rfc3339.DateTime", input: dt, expected: "2023-04-04T12:30:00-04:00"},
+		{name: "*rfc3339.DateTime", input: &dt, expected: "2023-04-04T12:30:00-04:00"},
+		{name: "DateTime", input: DateTime{dt}, expected: "2023-04-04T12:30:00-04:00"},
+		{name: "time.Time", input: dt.Time.UTC(), expected: "2023-04-04T16:30:00Z"},
+		{name: "string", input: "2023-04-04t12:30:00z", expected: "2023-04-04T12:30:00Z"},
+		{name: "nil", input: nil, expected: nil},
+		{name: "nil pointer", input: nilDateTime, expected: nil},
+		{name: "zero", input: rfc3339.DateTime{}, expected: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			found, err := SerializeDateTime(test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, found)
+		})
+	}
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		_, err := SerializeDateTime("yesterday")
+		assert.EqualError(t, err, "DateTime cannot represent an invalid date-time-string yesterday.")
+
+		_, err = SerializeDateTime(true)
+		assert.EqualError(t, err, "DateTime cannot be serialized from a non string, non numeric or non Date type true")
+	})
+}
+
+func TestParseDateTimeLiteral(t *testing.T) {
+	found, err := ParseDateTimeLiteral(&ast.StringValue{Value: "2023-04-04T12:30:00Z"})
+	require.NoError(t, err)
+	assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-04T12:30:00Z"), found)
+
+	_, err = ParseDateTimeLiteral(&ast.IntValue{Value: "42"})
+	assert.EqualError(t, err, "DateTime cannot represent non string or Date type 42")
+
+	_, err = ParseDateTimeLiteral(&ast.StringValue{Value: "2023-04-04 12:30:00Z"})
+	assert.EqualError(t, err, "DateTime cannot represent an invalid date-time-string 2023-04-04 12:30:00Z.")
+}
+
+func TestDateTimeScalar(t *testing.T) {
+	var received rfc3339.DateTime
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"shift": &graphql.Field{
+					Type: DateTimeScalar,
+					Args: graphql.FieldConfigArgument{
+						"at": &graphql.ArgumentConfig{Type: graphql.NewNonNull(DateTimeScalar)},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						received = p.Args["at"].(rfc3339.DateTime)
+						return received.Add(time.Hour), nil
+					},
+				},
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	t.Run("parses literals", func(t *testing.T) {
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `{ shift(at: "2023-04-04T12:30:00-04:00") }`,
+		})
+		require.Empty(t, result.Errors)
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-04T12:30:00-04:00"), received)
+		assert.Equal(t, map[string]any{"shift": "2023-04-04T13:30:00-04:00"}, result.Data)
+	})
+
+	t.Run("parses variables", func(t *testing.T) {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  `query ($at: DateTime!) { shift(at: $at) }`,
+			VariableValues: map[string]any{"at": "2023-04-04T12:30:00.123Z"},
+		})
+		require.Empty(t, result.Errors)
+		assert.Equal(t, map[string]any{"shift": "2023-04-04T13:30:00.123Z"}, result.Data)
+	})
+
+	t.Run("rejects invalid literals", func(t *testing.T) {
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: `{ shift(at: "2023-04-04") }`,
+		})
+		require.NotEmpty(t, result.Errors)
+	})
+
+	t.Run("rejects invalid variables", func(t *testing.T) {
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  `query ($at: DateTime!) { shift(at: $at) }`,
+			VariableValues: map[string]any{"at": "now"},
+		})
+		require.NotEmpty(t, result.Errors)
+	})
+}
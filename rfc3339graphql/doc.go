@@ -0,0 +1,28 @@
+// Package rfc3339graphql provides the `DateTime` and `Date` GraphQL scalars,
+// as specified by graphql-scalars (https://the-guild.dev/graphql/scalars),
+// which represent RFC 3339 date-time and full-date strings.
+//
+// For github.com/99designs/gqlgen, the [DateTime] and [FullDate] wrapper
+// types implement the MarshalGQL and UnmarshalGQL methods of its
+// `graphql.Marshaler` and `graphql.Unmarshaler` interfaces. Bind them to the
+// scalars in gqlgen.yml:
+//
+//	models:
+//	  DateTime:
+//	    model: github.com/jsumners/go-rfc3339/rfc3339graphql.DateTime
+//	  Date:
+//	    model: github.com/jsumners/go-rfc3339/rfc3339graphql.FullDate
+//
+// For github.com/graphql-go/graphql, [DateTimeScalar] and [DateScalar]
+// provide the Serialize, ParseValue, and ParseLiteral functions. Parsed
+// values are [rfc3339.DateTime] and [rfc3339.FullDate] instances.
+//
+// Parsing is strict and uses [rfc3339.NewDateTimeFromString] and
+// [rfc3339.NewFullDateFromString]. Invalid input results in the error
+// messages defined by graphql-scalars, e.g. `DateTime cannot represent an
+// invalid date-time-string 2023-04-04.`. The graphql-go library cannot
+// report errors from its scalar functions, so it reports invalid input with
+// its own messages; [ParseDateTime], [ParseDate], and their literal
+// counterparts can be used directly to get the specified messages. Zero
+// values serialize as null.
+package rfc3339graphql
@@ -0,0 +1,144 @@
+package rfc3339graphql
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jsumners/go-rfc3339"
+)
+
+// DateScalar is the graphql-go definition of the `Date` scalar. Parsed
+// values are [rfc3339.FullDate] instances.
+var DateScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Date",
+	Description: "A date string, such as 2007-12-03, compliant with the `full-date` format outlined in section 5.6 of the RFC 3339 profile of the ISO 8601 standard for representation of dates and times using the Gregorian calendar.",
+	Serialize: func(value any) any {
+		serialized, err := SerializeDate(value)
+		if err != nil {
+			return nil
+		}
+		return serialized
+	},
+	ParseValue: func(value any) any {
+		fd, err := ParseDate(value)
+		if err != nil {
+			return nil
+		}
+		return fd
+	},
+	ParseLiteral: func(valueAST ast.Value) any {
+		fd, err := ParseDateLiteral(valueAST)
+		if err != nil {
+			return nil
+		}
+		return fd
+	},
+})
+
+// FullDate implements the gqlgen marshaling methods for the `Date` scalar.
+type FullDate struct {
+	rfc3339.FullDate
+}
+
+// MarshalGQL implements the gqlgen `graphql.Marshaler` interface.
+func (fd FullDate) MarshalGQL(w io.Writer) {
+	if fd.IsZero() {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.Quote(fd.ToString()))
+}
+
+// UnmarshalGQL implements the gqlgen `graphql.Unmarshaler` interface.
+func (fd *FullDate) UnmarshalGQL(v any) error {
+	if v == nil {
+		fd.FullDate = rfc3339.FullDate{}
+		return nil
+	}
+
+	parsed, err := ParseDate(v)
+	if err != nil {
+		return err
+	}
+	fd.FullDate = parsed
+
+	return nil
+}
+
+// SerializeDate converts a result value to the `Date` scalar's serialized
+// form. Supported values are [rfc3339.FullDate], [FullDate], [time.Time],
+// pointers to them, and full-date strings. The date of a [time.Time] is
+// taken in its own location. Nil pointers and zero values serialize to nil.
+func SerializeDate(value any) (any, error) {
+	var fd rfc3339.FullDate
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case rfc3339.FullDate:
+		fd = v
+	case *rfc3339.FullDate:
+		if v == nil {
+			return nil, nil
+		}
+		fd = *v
+	case FullDate:
+		fd = v.FullDate
+	case *FullDate:
+		if v == nil {
+			return nil, nil
+		}
+		fd = v.FullDate
+	case time.Time:
+		fd = rfc3339.FullDate{Time: v}
+	case *time.Time:
+		if v == nil {
+			return nil, nil
+		}
+		fd = rfc3339.FullDate{Time: *v}
+	case string:
+		parsed, err := rfc3339.NewFullDateFromString(v)
+		if err != nil {
+			return nil, fmt.Errorf("Date cannot represent an invalid date-string %s.", v)
+		}
+		fd = parsed
+	default:
+		return nil, fmt.Errorf("Date cannot represent a non string, or non Date type %s", stringify(value))
+	}
+
+	if fd.IsZero() {
+		return nil, nil
+	}
+	return fd.ToString(), nil
+}
+
+// ParseDate parses an input value, e.g. a variable, of the `Date` scalar.
+// Strings must be RFC 3339 full-date strings; [rfc3339.FullDate] values are
+// accepted as is.
+func ParseDate(value any) (rfc3339.FullDate, error) {
+	switch v := value.(type) {
+	case string:
+		fd, err := rfc3339.NewFullDateFromString(v)
+		if err != nil {
+			return rfc3339.FullDate{}, fmt.Errorf("Date cannot represent an invalid date-string %s.", v)
+		}
+		return fd, nil
+	case rfc3339.FullDate:
+		return v, nil
+	default:
+		return rfc3339.FullDate{}, fmt.Errorf("Date cannot represent non string type %s", stringify(value))
+	}
+}
+
+// ParseDateLiteral parses an inline query literal of the `Date` scalar,
+// which must be a string.
+func ParseDateLiteral(valueAST ast.Value) (rfc3339.FullDate, error) {
+	str, ok := valueAST.(*ast.StringValue)
+	if !ok {
+		return rfc3339.FullDate{}, fmt.Errorf("Date cannot represent non-string type %v", literalValue(valueAST))
+	}
+	return ParseDate(str.Value)
+}
@@ -0,0 +1,123 @@
+package rfc3339graphql
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullDate_gqlgen(t *testing.T) {
+	t.Run("marshals quoted strings", func(t *testing.T) {
+		var buf bytes.Buffer
+		FullDate{rfc3339.MustParseDateString("2023-04-04")}.MarshalGQL(&buf)
+		assert.Equal(t, `"2023-04-04"`, buf.String())
+	})
+
+	t.Run("marshals zero as null", func(t *testing.T) {
+		var buf bytes.Buffer
+		FullDate{}.MarshalGQL(&buf)
+		assert.Equal(t, "null", buf.String())
+	})
+
+	t.Run("unmarshals strings", func(t *testing.T) {
+		var found FullDate
+		require.NoError(t, found.UnmarshalGQL("2023-04-04"))
+		assert.Equal(t, rfc3339.MustParseDateString("2023-04-04"), found.FullDate)
+
+		require.NoError(t, found.UnmarshalGQL(nil))
+		assert.True(t, found.IsZero())
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		var found FullDate
+		err := found.UnmarshalGQL("2023-04-04T00:00:00Z")
+		assert.EqualError(t, err, "Date cannot represent an invalid date-string 2023-04-04T00:00:00Z.")
+
+		err = found.UnmarshalGQL(int64(20230404))
+		assert.EqualError(t, err, "Date cannot represent non string type 20230404")
+	})
+}
+
+func TestSerializeDate(t *testing.T) {
+	fd := rfc3339.MustParseDateString("2023-04-04")
+	location := time.FixedZone("EDT", -4*60*60)
+
+	tests := []struct {
+		name     string
+		input    any
+		expected any
+	}{
+		{name: "rfc3339.FullDate", input: fd, expected: "2023-04-04"},
+		{name: "*rfc3339.FullDate", input: &fd, expected: "2023-04-04"},
+		{name: "FullDate", input: FullDate{fd}, expected: "2023-04-04"},
+		{name: "time.Time", input: time.Date(2023, time.April, 4, 23, 0, 0, 0, location), expected: "2023-04-04"},
+		{name: "string", input: "2023-04-04", expected: "2023-04-04"},
+		{name: "nil", input: nil, expected: nil},
+		{name: "zero", input: rfc3339.FullDate{}, expected: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			found, err := SerializeDate(test.input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, found)
+		})
+	}
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		_, err := SerializeDate("04/04/2023")
+		assert.EqualError(t, err, "Date cannot represent an invalid date-string 04/04/2023.")
+
+		_, err = SerializeDate([]int{1})
+		assert.EqualError(t, err, "Date cannot represent a non string, or non Date type [1]")
+	})
+}
+
+func TestParseDateLiteral(t *testing.T) {
+	found, err := ParseDateLiteral(&ast.StringValue{Value: "2023-04-04"})
+	require.NoError(t, err)
+	assert.Equal(t, rfc3339.MustParseDateString("2023-04-04"), found)
+
+	_, err = ParseDateLiteral(&ast.BooleanValue{Value: true})
+	assert.EqualError(t, err, "Date cannot represent non-string type true")
+}
+
+func TestDateScalar(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"next": &graphql.Field{
+					Type: DateScalar,
+					Args: graphql.FieldConfigArgument{
+						"day": &graphql.ArgumentConfig{Type: graphql.NewNonNull(DateScalar)},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						day := p.Args["day"].(rfc3339.FullDate)
+						return rfc3339.FullDate{Time: day.AddDate(0, 0, 1)}, nil
+					},
+				},
+			},
+		}),
+	})
+	require.NoError(t, err)
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ next(day: "2023-02-28") }`,
+	})
+	require.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"next": "2023-03-01"}, result.Data)
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($day: Date!) { next(day: $day) }`,
+		VariableValues: map[string]any{"day": "2023-02-28T00:00:00Z"},
+	})
+	require.NotEmpty(t, result.Errors)
+}
@@ -0,0 +1,18 @@
+module github.com/jsumners/go-rfc3339/rfc3339graphql
+
+go 1.24
+
+require (
+	github.com/graphql-go/graphql v0.8.1
+	github.com/jsumners/go-rfc3339 v1.3.0
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/jsumners/go-reggie v1.0.0-rc.2 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/samber/mo v1.11.0 // indirect
+	github.com/spf13/cast v1.6.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,16 @@
+package rfc3339graphql
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// stringify renders a value the way JavaScript's JSON.stringify does, for
+// use in error messages.
+func stringify(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
@@ -0,0 +1,19 @@
+// Package rfc3339log processes free-form log text that contains embedded
+// RFC 3339 date-time tokens, such as `2023-04-04T12:30:00.5-04:00`. It can
+// rewrite every token into a single UTC offset, filter lines to a time
+// window, and sort lines by their timestamps.
+//
+// Tokens are located with [rfc3339.FindAll], so that they are recognized
+// and interpreted exactly as by the rest of this module. A token must not be
+// directly preceded or followed by a letter or digit.
+//
+// A line's timestamp is its first token. Lines without a token, such as the
+// lines of a stack trace, are continuations of the preceding line: they are
+// kept with it when filtering and sorting. Lines that appear before the
+// first timestamped line have no timestamp; they are dropped when a time
+// window is set, and sort first.
+//
+// Input is processed one line at a time. Filtering and rewriting stream the
+// output, while sorting necessarily holds all lines in memory until the
+// input is exhausted.
+package rfc3339log
@@ -0,0 +1,161 @@
+package rfc3339log
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+type options struct {
+	location *time.Location
+	since    rfc3339.DateTime
+	until    rfc3339.DateTime
+	sort     bool
+}
+
+// Option configures [Process].
+type Option func(*options)
+
+// WithOffset rewrites every date-time token into the location, e.g.
+// [time.UTC] or the result of [rfc3339.LocationFromOffset].
+func WithOffset(loc *time.Location) Option {
+	return func(o *options) {
+		o.location = loc
+	}
+}
+
+// WithSince keeps only lines whose timestamp is at or after the date-time.
+func WithSince(dt rfc3339.DateTime) Option {
+	return func(o *options) {
+		o.since = dt
+	}
+}
+
+// WithUntil keeps only lines whose timestamp is before the date-time.
+func WithUntil(dt rfc3339.DateTime) Option {
+	return func(o *options) {
+		o.until = dt
+	}
+}
+
+// WithSort orders lines by their timestamps. Lines with equal timestamps
+// keep their input order.
+func WithSort() Option {
+	return func(o *options) {
+		o.sort = true
+	}
+}
+
+// entry is a timestamped line along with its continuation lines.
+type entry struct {
+	dateTime rfc3339.DateTime
+	hasTime  bool
+	lines    []string
+}
+
+// Process reads lines of text from r, and writes them to w according to the
+// options. Timestamps are determined before any offset rewrite, though the
+// result is the same since rewriting does not change the instant.
+func Process(r io.Reader, w io.Writer, opts ...Option) error {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	reader := bufio.NewReader(r)
+	writer := bufio.NewWriter(w)
+
+	var entries []entry
+	keep := !o.windowed()
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			dt, hasTime := FirstDateTime(line)
+			if hasTime {
+				keep = o.inWindow(dt)
+			}
+			if o.location != nil {
+				line = RewriteLine(line, o.location)
+			}
+			if o.sort && !strings.HasSuffix(line, "\n") {
+				// The final line may move when sorted, so it must be
+				// terminated to remain a separate line.
+				line += "\n"
+			}
+
+			switch {
+			case o.sort && hasTime:
+				if keep {
+					entries = append(entries, entry{dateTime: dt, hasTime: true, lines: []string{line}})
+				}
+			case o.sort:
+				if keep {
+					if len(entries) == 0 {
+						entries = append(entries, entry{})
+					}
+					last := &entries[len(entries)-1]
+					last.lines = append(last.lines, line)
+				}
+			case keep:
+				if _, werr := writer.WriteString(line); werr != nil {
+					return werr
+				}
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.sort {
+		slices.SortStableFunc(entries, compareEntries)
+		for _, e := range entries {
+			for _, line := range e.lines {
+				if _, err := writer.WriteString(line); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return writer.Flush()
+}
+
+// windowed reports whether a time window is set.
+func (o options) windowed() bool {
+	return !o.since.IsZero() || !o.until.IsZero()
+}
+
+// inWindow reports whether a timestamp is within the time window.
+func (o options) inWindow(dt rfc3339.DateTime) bool {
+	if !o.since.IsZero() && dt.Before(o.since.Time) {
+		return false
+	}
+	if !o.until.IsZero() && !dt.Before(o.until.Time) {
+		return false
+	}
+	return true
+}
+
+// compareEntries orders entries without a timestamp first, and then by
+// timestamp.
+func compareEntries(a entry, b entry) int {
+	switch {
+	case !a.hasTime && !b.hasTime:
+		return 0
+	case !a.hasTime:
+		return -1
+	case !b.hasTime:
+		return 1
+	}
+	return a.dateTime.Compare(b.dateTime.Time)
+}
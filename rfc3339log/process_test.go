@@ -0,0 +1,117 @@
+package rfc3339log
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleLog = `starting up
+2023-04-04T12:30:00-04:00 INFO request id=1
+2023-04-04T16:00:00Z ERROR failed
+  at main.go:12
+  at main.go:40
+2023-04-04T18:45:00+02:00 INFO request id=2
+2023-04-04T17:00:00.25Z WARN slow`
+
+func process(t *testing.T, input string, opts ...Option) string {
+	t.Helper()
+	var b strings.Builder
+	require.NoError(t, Process(strings.NewReader(input), &b, opts...))
+	return b.String()
+}
+
+func TestProcess(t *testing.T) {
+	t.Run("copies input without options", func(t *testing.T) {
+		assert.Equal(t, sampleLog, process(t, sampleLog))
+	})
+
+	t.Run("rewrites offsets", func(t *testing.T) {
+		expected := `starting up
+2023-04-04T16:30:00Z INFO request id=1
+2023-04-04T16:00:00Z ERROR failed
+  at main.go:12
+  at main.go:40
+2023-04-04T16:45:00Z INFO request id=2
+2023-04-04T17:00:00.25Z WARN slow`
+		assert.Equal(t, expected, process(t, sampleLog, WithOffset(time.UTC)))
+	})
+
+	t.Run("filters by window", func(t *testing.T) {
+		expected := `2023-04-04T12:30:00-04:00 INFO request id=1
+2023-04-04T16:00:00Z ERROR failed
+  at main.go:12
+  at main.go:40
+2023-04-04T18:45:00+02:00 INFO request id=2
+`
+		found := process(t, sampleLog,
+			WithSince(rfc3339.MustParseDateTimeString("2023-04-04T16:00:00Z")),
+			WithUntil(rfc3339.MustParseDateTimeString("2023-04-04T17:00:00.25Z")),
+		)
+		assert.Equal(t, expected, found)
+	})
+
+	t.Run("filters with only an until", func(t *testing.T) {
+		found := process(t, sampleLog, WithUntil(rfc3339.MustParseDateTimeString("2023-04-04T16:00:00Z")))
+		assert.Equal(t, "", found)
+
+		found = process(t, sampleLog, WithUntil(rfc3339.MustParseDateTimeString("2023-04-04T16:30:00.001Z")))
+		assert.Equal(t, "2023-04-04T12:30:00-04:00 INFO request id=1\n2023-04-04T16:00:00Z ERROR failed\n  at main.go:12\n  at main.go:40\n", found)
+	})
+
+	t.Run("sorts with continuation lines", func(t *testing.T) {
+		expected := `starting up
+2023-04-04T16:00:00Z ERROR failed
+  at main.go:12
+  at main.go:40
+2023-04-04T12:30:00-04:00 INFO request id=1
+2023-04-04T18:45:00+02:00 INFO request id=2
+2023-04-04T17:00:00.25Z WARN slow
+`
+		assert.Equal(t, expected, process(t, sampleLog, WithSort()))
+	})
+
+	t.Run("combines options", func(t *testing.T) {
+		expected := `2023-04-04T12:00:00-04:00 ERROR failed
+  at main.go:12
+  at main.go:40
+2023-04-04T12:30:00-04:00 INFO request id=1
+2023-04-04T12:45:00-04:00 INFO request id=2
+`
+		found := process(t, sampleLog,
+			WithSort(),
+			WithOffset(rfc3339.LocationFromOffset(-4*60*60)),
+			WithSince(rfc3339.MustParseDateTimeString("2023-04-04T12:00:00-04:00")),
+			WithUntil(rfc3339.MustParseDateTimeString("2023-04-04T13:00:00-04:00")),
+		)
+		assert.Equal(t, expected, found)
+	})
+
+	t.Run("keeps input order for equal timestamps", func(t *testing.T) {
+		input := "2023-04-04T12:00:00Z b\n2023-04-04T08:00:00-04:00 a\n2023-04-04T11:00:00Z c\n"
+		expected := "2023-04-04T11:00:00Z c\n2023-04-04T12:00:00Z b\n2023-04-04T08:00:00-04:00 a\n"
+		assert.Equal(t, expected, process(t, input, WithSort()))
+	})
+
+	t.Run("handles long lines", func(t *testing.T) {
+		line := "2023-04-04T12:00:00Z " + strings.Repeat("x", 256*1024) + "\n"
+		assert.Equal(t, line, process(t, line))
+	})
+
+	t.Run("returns read errors", func(t *testing.T) {
+		var b strings.Builder
+		err := Process(errorReader{}, &b)
+		assert.ErrorContains(t, err, "read failed")
+	})
+}
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
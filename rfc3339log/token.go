@@ -0,0 +1,78 @@
+package rfc3339log
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+// token is a date-time found in a line. The start and end are byte offsets.
+type token struct {
+	start     int
+	end       int
+	dateTime  rfc3339.DateTime
+	precision int
+}
+
+// findTokens locates the date-time tokens of a line.
+func findTokens(line string) []token {
+	found := rfc3339.FindAll(line, rfc3339.KindDateTime)
+	tokens := make([]token, 0, len(found))
+	for _, tok := range found {
+		tokens = append(tokens, token{
+			start:     int(tok.Start),
+			end:       int(tok.End),
+			dateTime:  tok.Value.(rfc3339.DateTime),
+			precision: fractionDigits(tok.Text),
+		})
+	}
+	return tokens
+}
+
+// fractionDigits counts the fractional second digits of a date-time string.
+func fractionDigits(text string) int {
+	dot := strings.IndexByte(text, '.')
+	if dot < 0 {
+		return 0
+	}
+	digits := 0
+	for _, c := range text[dot+1:] {
+		if c < '0' || c > '9' {
+			break
+		}
+		digits += 1
+	}
+	return digits
+}
+
+// FirstDateTime returns the first date-time token of a line.
+func FirstDateTime(line string) (rfc3339.DateTime, bool) {
+	tokens := findTokens(line)
+	if len(tokens) == 0 {
+		return rfc3339.DateTime{}, false
+	}
+	return tokens[0].dateTime, true
+}
+
+// RewriteLine rewrites every date-time token of a line into the location,
+// keeping the number of fractional second digits of each token.
+func RewriteLine(line string, loc *time.Location) string {
+	tokens := findTokens(line)
+	if len(tokens) == 0 {
+		return line
+	}
+
+	var b strings.Builder
+	b.Grow(len(line))
+	previous := 0
+	for _, tok := range tokens {
+		b.WriteString(line[previous:tok.start])
+		rewritten := rfc3339.NewFromTime(tok.dateTime.In(loc))
+		b.WriteString(rewritten.ToStringPrecision(tok.precision))
+		previous = tok.end
+	}
+	b.WriteString(line[previous:])
+
+	return b.String()
+}
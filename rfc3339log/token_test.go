@@ -0,0 +1,77 @@
+package rfc3339log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstDateTime(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{line: "2023-04-04T12:30:00Z INFO started", expected: "2023-04-04T12:30:00Z"},
+		{line: `{"time":"2023-04-04T12:30:00.5-04:00","msg":"x"}`, expected: "2023-04-04T12:30:00.5-04:00"},
+		{line: "[2023-04-04t12:30:00z] then 2023-04-05T00:00:00Z", expected: "2023-04-04T12:30:00Z"},
+		{line: "id=x2023-04-04T12:30:00Z at=2023-04-04T13:00:00Z", expected: "2023-04-04T13:00:00Z"},
+		{line: "2023-04-04T12:30:00+05:300 is not a token", expected: ""},
+		{line: "2023-04-04T12:30:00Zulu is not a token", expected: ""},
+		{line: "2023-04-04 12:30:00Z is not a date-time", expected: ""},
+		{line: "no timestamps here", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.line, func(t *testing.T) {
+			found, ok := FirstDateTime(test.line)
+			if test.expected == "" {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, rfc3339.MustParseDateTimeString(test.expected), found)
+		})
+	}
+}
+
+func TestRewriteLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		location *time.Location
+		expected string
+	}{
+		{
+			name:     "rewrites every token",
+			line:     "from 2023-04-04T12:30:00-04:00 to 2023-04-04T18:00:00+02:00\n",
+			location: time.UTC,
+			expected: "from 2023-04-04T16:30:00Z to 2023-04-04T16:00:00Z\n",
+		},
+		{
+			name:     "keeps precision",
+			line:     "t=2023-04-04T12:30:00.500Z u=2023-04-04T12:30:00.000000001Z",
+			location: rfc3339.LocationFromOffset(-4 * 60 * 60),
+			expected: "t=2023-04-04T08:30:00.500-04:00 u=2023-04-04T08:30:00.000000001-04:00",
+		},
+		{
+			name:     "changes the date",
+			line:     "2023-04-04T23:30:00-04:00",
+			location: rfc3339.LocationFromOffset(9 * 60 * 60),
+			expected: "2023-04-05T12:30:00+09:00",
+		},
+		{
+			name:     "leaves other text alone",
+			line:     "no timestamps 2023-04-04 here",
+			location: time.UTC,
+			expected: "no timestamps 2023-04-04 here",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, RewriteLine(test.line, test.location))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package rfc3339logical
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/ocf"
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const avroSchema = `{
+	"type": "record",
+	"name": "event",
+	"fields": [
+		{"name": "day", "type": {"type": "int", "logicalType": "date"}},
+		{"name": "millis", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+		{"name": "micros", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+		{"name": "local", "type": {"type": "long", "logicalType": "local-timestamp-micros"}}
+	]
+}`
+
+type avroRaw struct {
+	Day    int32 `avro:"day"`
+	Millis int64 `avro:"millis"`
+	Micros int64 `avro:"micros"`
+	Local  int64 `avro:"local"`
+}
+
+type avroNative struct {
+	Day    time.Time `avro:"day"`
+	Millis time.Time `avro:"millis"`
+	Micros time.Time `avro:"micros"`
+	Local  time.Time `avro:"local"`
+}
+
+func TestAvro_RoundTrip(t *testing.T) {
+	day := rfc3339.MustParseDateString("2023-04-01")
+	dt := rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.123456-04:00")
+
+	millis, err := ToTimestamp(dt, Millis, AllowTruncation())
+	require.NoError(t, err)
+	micros, err := ToTimestamp(dt, Micros)
+	require.NoError(t, err)
+	local, err := ToLocalTimestamp(dt, Micros)
+	require.NoError(t, err)
+
+	input := avroRaw{Day: ToDate(day), Millis: millis, Micros: micros, Local: local}
+
+	var buf bytes.Buffer
+	enc, err := ocf.NewEncoder(avroSchema, &buf)
+	require.NoError(t, err)
+	require.NoError(t, enc.Encode(input))
+	require.NoError(t, enc.Close())
+
+	dec, err := ocf.NewDecoder(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.True(t, dec.HasNext())
+	var found avroRaw
+	require.NoError(t, dec.Decode(&found))
+	assert.Equal(t, input, found)
+
+	foundDay, err := FromDate(found.Day)
+	require.NoError(t, err)
+	assert.Equal(t, day, foundDay)
+
+	foundMillis, err := FromTimestamp(found.Millis, Millis)
+	require.NoError(t, err)
+	assert.Equal(t, "2023-04-01T12:30:00.123Z", foundMillis.ToString())
+
+	foundMicros, err := FromTimestamp(found.Micros, Micros)
+	require.NoError(t, err)
+	assert.True(t, dt.Equal(foundMicros.Time))
+
+	foundLocal, err := FromLocalTimestamp(found.Local, Micros, dt.Location())
+	require.NoError(t, err)
+	assert.Equal(t, dt, foundLocal)
+
+	// The library's own logical type handling must agree with ours.
+	schema := avro.MustParse(avroSchema)
+	data, err := avro.Marshal(schema, input)
+	require.NoError(t, err)
+	var native avroNative
+	require.NoError(t, avro.Unmarshal(schema, data, &native))
+	assert.Equal(t, "2023-04-01", native.Day.Format(time.DateOnly))
+	assert.True(t, foundMillis.Equal(native.Millis))
+	assert.True(t, dt.Equal(native.Micros))
+	assert.Equal(t, "2023-04-01T08:30:00.123456", native.Local.UTC().Format("2006-01-02T15:04:05.999999"))
+}
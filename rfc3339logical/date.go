@@ -0,0 +1,24 @@
+package rfc3339logical
+
+import (
+	"fmt"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+// ToDate converts an [rfc3339.FullDate] to a `date` logical type value, the
+// number of days since 1970-01-01.
+func ToDate(fd rfc3339.FullDate) int32 {
+	return int32(fd.EpochDays())
+}
+
+// FromDate converts a `date` logical type value to an [rfc3339.FullDate]. An
+// error is returned if the date is outside the range a full-date can
+// represent, 0001-01-01 through 9999-12-31.
+func FromDate(days int32) (rfc3339.FullDate, error) {
+	fd, err := rfc3339.NewFullDateFromEpochDays(int64(days))
+	if err != nil {
+		return rfc3339.FullDate{}, fmt.Errorf("date out of full-date range: %d", days)
+	}
+	return fd, nil
+}
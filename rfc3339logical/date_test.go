@@ -0,0 +1,41 @@
+package rfc3339logical
+
+import (
+	"testing"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToDate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int32
+	}{
+		{"1970-01-01", 0},
+		{"2023-04-01", 19448},
+		{"1940-10-09", -10676},
+		{"0001-01-01", -719162},
+		{"9999-12-31", 2932896},
+	}
+
+	for _, test := range tests {
+		days := ToDate(rfc3339.MustParseDateString(test.input))
+		assert.Equal(t, test.expected, days)
+
+		found, err := FromDate(days)
+		require.NoError(t, err)
+		assert.Equal(t, rfc3339.MustParseDateString(test.input), found)
+	}
+}
+
+func TestFromDate(t *testing.T) {
+	t.Run("returns error for out of range values", func(t *testing.T) {
+		_, err := FromDate(2932897)
+		assert.EqualError(t, err, "date out of full-date range: 2932897")
+
+		_, err = FromDate(-719163)
+		assert.EqualError(t, err, "date out of full-date range: -719163")
+	})
+}
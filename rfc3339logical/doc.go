@@ -0,0 +1,17 @@
+// Package rfc3339logical converts the rfc3339 types to and from the integer
+// representations of the date and timestamp logical types shared by Apache
+// Avro and Apache Parquet:
+//
+//   - `date` is the number of days since 1970-01-01.
+//   - `timestamp-millis`, `timestamp-micros`, and `timestamp-nanos` (Parquet
+//     `TIMESTAMP` adjusted to UTC) are the number of units since
+//     1970-01-01T00:00:00Z.
+//   - `local-timestamp-millis`, `local-timestamp-micros`, and
+//     `local-timestamp-nanos` (Parquet `TIMESTAMP` not adjusted to UTC) are
+//     the number of units since 1970-01-01T00:00:00 in an unspecified
+//     local time.
+//
+// Conversions are exact: if a value cannot be represented at the requested
+// unit without truncation an [ErrPrecisionLoss] error is returned, unless
+// [AllowTruncation] is supplied.
+package rfc3339logical
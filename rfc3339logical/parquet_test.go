@@ -0,0 +1,54 @@
+package rfc3339logical
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type parquetRow struct {
+	Day   int32 `parquet:"day,date"`
+	Nanos int64 `parquet:"nanos,timestamp(nanosecond)"`
+}
+
+func TestParquet_RoundTrip(t *testing.T) {
+	inputs := []struct {
+		day string
+		dt  string
+	}{
+		{"2023-04-01", "2023-04-01T08:30:00.123456789-04:00"},
+		{"1969-12-31", "1969-12-31T23:59:59.999999999Z"},
+	}
+
+	rows := make([]parquetRow, 0, len(inputs))
+	for _, input := range inputs {
+		nanos, err := ToTimestamp(rfc3339.MustParseDateTimeString(input.dt), Nanos)
+		require.NoError(t, err)
+		rows = append(rows, parquetRow{
+			Day:   ToDate(rfc3339.MustParseDateString(input.day)),
+			Nanos: nanos,
+		})
+	}
+
+	var buf bytes.Buffer
+	err := parquet.Write(&buf, rows)
+	require.NoError(t, err)
+
+	found, err := parquet.Read[parquetRow](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, rows, found)
+
+	for i, input := range inputs {
+		day, err := FromDate(found[i].Day)
+		require.NoError(t, err)
+		assert.Equal(t, input.day, day.ToString())
+
+		dt, err := FromTimestamp(found[i].Nanos, Nanos)
+		require.NoError(t, err)
+		assert.True(t, rfc3339.MustParseDateTimeString(input.dt).Equal(dt.Time))
+	}
+}
@@ -0,0 +1,157 @@
+package rfc3339logical
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+// ErrPrecisionLoss is returned when a value cannot be represented at the
+// requested [Unit] without truncation, and [AllowTruncation] was not
+// supplied.
+var ErrPrecisionLoss = errors.New("timestamp precision would be lost")
+
+// Unit is the precision of a timestamp logical type.
+type Unit int
+
+const (
+	// Millis is the unit of the `timestamp-millis` logical types.
+	Millis Unit = iota
+	// Micros is the unit of the `timestamp-micros` logical types.
+	Micros
+	// Nanos is the unit of the `timestamp-nanos` logical types.
+	Nanos
+)
+
+// perSecond is the number of units in a second.
+func (u Unit) perSecond() int64 {
+	switch u {
+	case Millis:
+		return 1e3
+	case Micros:
+		return 1e6
+	default:
+		return 1e9
+	}
+}
+
+func (u Unit) String() string {
+	switch u {
+	case Millis:
+		return "millis"
+	case Micros:
+		return "micros"
+	default:
+		return "nanos"
+	}
+}
+
+// Option configures a conversion to a timestamp logical type.
+type Option func(*options)
+
+type options struct {
+	allowTruncation bool
+}
+
+// AllowTruncation permits conversions that cannot be represented exactly at
+// the requested [Unit]. The value is truncated toward the past.
+func AllowTruncation() Option {
+	return func(o *options) {
+		o.allowTruncation = true
+	}
+}
+
+// ToTimestamp converts an [rfc3339.DateTime] to a `timestamp` logical type
+// value, the number of units since 1970-01-01T00:00:00Z. The UTC offset is
+// not represented by the value. An error is returned if the value does not
+// fit in 64 bits, which limits [Nanos] to the years 1678 through 2261.
+func ToTimestamp(dt rfc3339.DateTime, unit Unit, opts ...Option) (int64, error) {
+	return toUnits(dt.Unix(), dt.Nanosecond(), dt.ToString(), unit, opts)
+}
+
+// FromTimestamp converts a `timestamp` logical type value to an
+// [rfc3339.DateTime] at the UTC (+00:00) offset. An error is returned if the
+// value is outside the range a date-time can represent, years 0001 through
+// 9999.
+func FromTimestamp(value int64, unit Unit) (rfc3339.DateTime, error) {
+	t, err := fromUnits(value, unit)
+	if err != nil {
+		return rfc3339.DateTime{}, err
+	}
+	return rfc3339.NewFromTime(t.UTC()), nil
+}
+
+// ToLocalTimestamp converts an [rfc3339.DateTime] to a `local-timestamp`
+// logical type value. The wall clock time of the date-time, in its own UTC
+// offset, is counted from 1970-01-01T00:00:00 as if it were at UTC. The
+// same limits as [ToTimestamp] apply.
+func ToLocalTimestamp(dt rfc3339.DateTime, unit Unit, opts ...Option) (int64, error) {
+	_, offset := dt.Zone()
+	return toUnits(dt.Unix()+int64(offset), dt.Nanosecond(), dt.ToString(), unit, opts)
+}
+
+// FromLocalTimestamp converts a `local-timestamp` logical type value to an
+// [rfc3339.DateTime] by interpreting its wall clock time in the location.
+// The location is required, because a local timestamp does not carry an
+// offset. The same limits as [FromTimestamp] apply.
+func FromLocalTimestamp(value int64, unit Unit, loc *time.Location) (rfc3339.DateTime, error) {
+	if loc == nil {
+		return rfc3339.DateTime{}, errors.New("location is required for local timestamps")
+	}
+
+	t, err := fromUnits(value, unit)
+	if err != nil {
+		return rfc3339.DateTime{}, err
+	}
+	t = t.UTC()
+
+	return rfc3339.NewFromTime(time.Date(
+		t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(),
+		loc,
+	)), nil
+}
+
+// toUnits converts seconds and nanoseconds since the epoch to units since
+// the epoch.
+func toUnits(sec int64, nsec int, source string, unit Unit, opts []Option) (int64, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	perSecond := unit.perSecond()
+	nsPerUnit := int64(time.Second) / perSecond
+	units := int64(nsec) / nsPerUnit
+	if int64(nsec)%nsPerUnit != 0 && !o.allowTruncation {
+		return 0, fmt.Errorf("%w: %s at %s", ErrPrecisionLoss, source, unit)
+	}
+
+	if sec > (math.MaxInt64-units)/perSecond || sec < math.MinInt64/perSecond {
+		return 0, fmt.Errorf("date-time out of timestamp-%s range: %s", unit, source)
+	}
+
+	return sec*perSecond + units, nil
+}
+
+// fromUnits converts units since the epoch to an instant, and verifies it is
+// within the four digit years of RFC 3339.
+func fromUnits(value int64, unit Unit) (time.Time, error) {
+	perSecond := unit.perSecond()
+	sec := value / perSecond
+	units := value % perSecond
+	if units < 0 {
+		units += perSecond
+		sec -= 1
+	}
+
+	t := time.Unix(sec, units*(int64(time.Second)/perSecond)).UTC()
+	if t.Year() < 1 || t.Year() > 9999 {
+		return time.Time{}, fmt.Errorf("timestamp-%s out of date-time range: %d", unit, value)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,113 @@
+package rfc3339logical
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToTimestamp(t *testing.T) {
+	t.Run("converts exact values", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			unit     Unit
+			expected int64
+		}{
+			{"2023-04-01T12:30:00.123Z", Millis, 1680352200123},
+			{"2023-04-01T08:30:00.123456-04:00", Micros, 1680352200123456},
+			{"2023-04-01T12:30:00.123456789Z", Nanos, 1680352200123456789},
+			{"1969-12-31T23:59:59.5Z", Millis, -500},
+			{"0001-01-01T00:00:00Z", Micros, -62135596800000000},
+		}
+
+		for _, test := range tests {
+			found, err := ToTimestamp(rfc3339.MustParseDateTimeString(test.input), test.unit)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, found)
+		}
+	})
+
+	t.Run("returns error for precision loss", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T12:30:00.1234Z")
+		_, err := ToTimestamp(dt, Millis)
+		assert.ErrorIs(t, err, ErrPrecisionLoss)
+		assert.EqualError(t, err, "timestamp precision would be lost: 2023-04-01T12:30:00.1234Z at millis")
+	})
+
+	t.Run("truncates toward the past when allowed", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected int64
+		}{
+			{"2023-04-01T12:30:00.1239Z", 1680352200123},
+			{"1969-12-31T23:59:59.9999Z", -1},
+		}
+
+		for _, test := range tests {
+			dt := rfc3339.MustParseDateTimeString(test.input)
+			found, err := ToTimestamp(dt, Millis, AllowTruncation())
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, found)
+		}
+	})
+
+	t.Run("returns error for out of range values", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2262-04-12T00:00:00Z")
+		_, err := ToTimestamp(dt, Nanos)
+		assert.EqualError(t, err, "date-time out of timestamp-nanos range: 2262-04-12T00:00:00Z")
+	})
+}
+
+func TestFromTimestamp(t *testing.T) {
+	t.Run("converts to UTC values", func(t *testing.T) {
+		tests := []struct {
+			input    int64
+			unit     Unit
+			expected string
+		}{
+			{1680352200123, Millis, "2023-04-01T12:30:00.123Z"},
+			{1680352200123456, Micros, "2023-04-01T12:30:00.123456Z"},
+			{1680352200123456789, Nanos, "2023-04-01T12:30:00.123456789Z"},
+			{-500, Millis, "1969-12-31T23:59:59.5Z"},
+		}
+
+		for _, test := range tests {
+			found, err := FromTimestamp(test.input, test.unit)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, found.ToString())
+		}
+	})
+
+	t.Run("returns error for out of range values", func(t *testing.T) {
+		_, err := FromTimestamp(253402300800000, Millis)
+		assert.EqualError(t, err, "timestamp-millis out of date-time range: 253402300800000")
+	})
+}
+
+func TestLocalTimestamp(t *testing.T) {
+	t.Run("converts the wall clock time", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.5-04:00")
+		found, err := ToLocalTimestamp(dt, Millis)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1680337800500), found)
+	})
+
+	t.Run("interprets the wall clock time in the location", func(t *testing.T) {
+		loc := rfc3339.LocationFromOffset(-4 * 60 * 60)
+		found, err := FromLocalTimestamp(1680337800500, Millis, loc)
+		require.NoError(t, err)
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.5-04:00"), found)
+
+		found, err = FromLocalTimestamp(1680337800500, Millis, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00.5Z", found.ToString())
+	})
+
+	t.Run("returns error without a location", func(t *testing.T) {
+		_, err := FromLocalTimestamp(0, Millis, nil)
+		assert.EqualError(t, err, "location is required for local timestamps")
+	})
+}
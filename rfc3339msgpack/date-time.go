@@ -0,0 +1,40 @@
+package rfc3339msgpack
+
+import (
+	"github.com/jsumners/go-rfc3339"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// DateTime encodes an [rfc3339.DateTime] as an RFC 3339 date-time string.
+// Precision and the UTC offset are preserved.
+type DateTime struct {
+	rfc3339.DateTime
+}
+
+// EncodeMsgpack implements the [msgpack.CustomEncoder] interface.
+func (dt DateTime) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if dt.IsZero() {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(dt.ToString())
+}
+
+// DecodeMsgpack implements the [msgpack.CustomDecoder] interface.
+func (dt *DateTime) DecodeMsgpack(dec *msgpack.Decoder) error {
+	if isNil, err := decodeNil(dec); isNil || err != nil {
+		dt.DateTime = rfc3339.DateTime{}
+		return err
+	}
+
+	str, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := rfc3339.NewDateTimeFromString(str)
+	if err != nil {
+		return err
+	}
+	dt.DateTime = parsed
+
+	return nil
+}
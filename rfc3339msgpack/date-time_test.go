@@ -0,0 +1,64 @@
+package rfc3339msgpack
+
+import (
+	"testing"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestDateTime(t *testing.T) {
+	t.Run("round trips values", func(t *testing.T) {
+		input := DateTime{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.005-04:00")}
+		data, err := msgpack.Marshal(input)
+		require.NoError(t, err)
+
+		var str string
+		err = msgpack.Unmarshal(data, &str)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00.005-04:00", str)
+
+		var found DateTime
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("round trips structs", func(t *testing.T) {
+		type entry struct {
+			Key     string
+			Created DateTime
+			Expires Timestamp
+		}
+
+		input := entry{
+			Key:     "a",
+			Created: DateTime{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00-04:00")},
+		}
+		data, err := msgpack.Marshal(input)
+		require.NoError(t, err)
+
+		var found entry
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"aa323032332d30342d3031", "input is not a date-time string: 2023-04-01"},
+			{"d6ff64282448", "msgpack: invalid code=d6 decoding string/bytes length"},
+		}
+
+		for _, test := range tests {
+			var found DateTime
+			err := msgpack.Unmarshal(mustHex(t, test.input), &found)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
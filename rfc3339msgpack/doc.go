@@ -0,0 +1,16 @@
+// Package rfc3339msgpack provides MessagePack encodings of
+// [rfc3339.DateTime] for use with github.com/vmihailenco/msgpack/v5. Each
+// encoding is a distinct wrapper type, so the encoding is chosen by the type
+// of a struct field:
+//
+//   - [Timestamp] uses the timestamp extension type (-1), in its 32, 64, or
+//     96 bit form, whichever is the smallest that fits the value. The UTC
+//     offset is not preserved.
+//   - [OffsetTimestamp] uses a two element array of the timestamp extension
+//     type and the UTC offset in seconds, so that the offset is preserved.
+//   - [DateTime] uses an RFC 3339 date-time string.
+//
+// Decoding is strict: each type only accepts its own encoding, and strings
+// are parsed with [rfc3339.NewDateTimeFromString]. Zero values are encoded
+// as nil, and nil decodes to zero values.
+package rfc3339msgpack
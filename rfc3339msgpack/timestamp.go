@@ -0,0 +1,176 @@
+package rfc3339msgpack
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// timestampExtID is the MessagePack extension type of timestamps.
+const timestampExtID int8 = -1
+
+// Timestamp encodes an [rfc3339.DateTime] as the MessagePack timestamp
+// extension type. Precision is preserved, but the UTC offset is not; decoded
+// values are at the UTC (+00:00) offset.
+type Timestamp struct {
+	rfc3339.DateTime
+}
+
+// OffsetTimestamp encodes an [rfc3339.DateTime] as a two element array: the
+// MessagePack timestamp extension type, and the UTC offset in seconds.
+// Precision and the UTC offset are preserved.
+type OffsetTimestamp struct {
+	rfc3339.DateTime
+}
+
+// EncodeMsgpack implements the [msgpack.CustomEncoder] interface.
+func (ts Timestamp) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if ts.IsZero() {
+		return enc.EncodeNil()
+	}
+	return encodeTimestamp(enc, ts.DateTime)
+}
+
+// DecodeMsgpack implements the [msgpack.CustomDecoder] interface.
+func (ts *Timestamp) DecodeMsgpack(dec *msgpack.Decoder) error {
+	if isNil, err := decodeNil(dec); isNil || err != nil {
+		ts.DateTime = rfc3339.DateTime{}
+		return err
+	}
+
+	parsed, err := decodeTimestamp(dec)
+	if err != nil {
+		return err
+	}
+	ts.DateTime = rfc3339.NewFromTime(parsed.UTC())
+
+	return nil
+}
+
+// EncodeMsgpack implements the [msgpack.CustomEncoder] interface.
+func (ts OffsetTimestamp) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if ts.IsZero() {
+		return enc.EncodeNil()
+	}
+
+	if err := enc.EncodeArrayLen(2); err != nil {
+		return err
+	}
+	if err := encodeTimestamp(enc, ts.DateTime); err != nil {
+		return err
+	}
+	_, offset := ts.Zone()
+
+	return enc.EncodeInt(int64(offset))
+}
+
+// DecodeMsgpack implements the [msgpack.CustomDecoder] interface.
+func (ts *OffsetTimestamp) DecodeMsgpack(dec *msgpack.Decoder) error {
+	if isNil, err := decodeNil(dec); isNil || err != nil {
+		ts.DateTime = rfc3339.DateTime{}
+		return err
+	}
+
+	length, err := dec.DecodeArrayLen()
+	if err != nil {
+		return err
+	}
+	if length != 2 {
+		return fmt.Errorf("offset timestamp must be an array of 2 elements, got: %d", length)
+	}
+
+	parsed, err := decodeTimestamp(dec)
+	if err != nil {
+		return err
+	}
+	offset, err := dec.DecodeInt()
+	if err != nil {
+		return err
+	}
+	// Offsets are limited to less than a day, as with RFC 3339 offsets.
+	if offset <= -86400 || offset >= 86400 {
+		return fmt.Errorf("offset timestamp offset out of range: %d", offset)
+	}
+
+	ts.DateTime = rfc3339.NewFromTime(parsed.In(rfc3339.LocationFromOffset(offset)))
+
+	return nil
+}
+
+// decodeNil consumes a nil value, if one is next.
+func decodeNil(dec *msgpack.Decoder) (bool, error) {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return false, err
+	}
+	if code != msgpcode.Nil {
+		return false, nil
+	}
+	return true, dec.DecodeNil()
+}
+
+// encodeTimestamp writes the smallest form of the timestamp extension type
+// that can represent the instant.
+func encodeTimestamp(enc *msgpack.Encoder, dt rfc3339.DateTime) error {
+	sec := dt.Unix()
+	nsec := uint32(dt.Nanosecond())
+
+	var data []byte
+	switch {
+	case nsec == 0 && sec >= 0 && sec <= 0xffffffff:
+		data = binary.BigEndian.AppendUint32(nil, uint32(sec))
+	case sec >= 0 && sec>>34 == 0:
+		data = binary.BigEndian.AppendUint64(nil, uint64(nsec)<<34|uint64(sec))
+	default:
+		data = binary.BigEndian.AppendUint32(nil, nsec)
+		data = binary.BigEndian.AppendUint64(data, uint64(sec))
+	}
+
+	if err := enc.EncodeExtHeader(timestampExtID, len(data)); err != nil {
+		return err
+	}
+	_, err := enc.Writer().Write(data)
+
+	return err
+}
+
+// decodeTimestamp reads any of the forms of the timestamp extension type.
+func decodeTimestamp(dec *msgpack.Decoder) (time.Time, error) {
+	extID, extLen, err := dec.DecodeExtHeader()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if extID != timestampExtID {
+		return time.Time{}, fmt.Errorf("timestamp must be extension type -1, got: %d", extID)
+	}
+
+	data := make([]byte, extLen)
+	if err := dec.ReadFull(data); err != nil {
+		return time.Time{}, err
+	}
+
+	var sec int64
+	var nsec uint32
+	switch extLen {
+	case 4:
+		sec = int64(binary.BigEndian.Uint32(data))
+	case 8:
+		value := binary.BigEndian.Uint64(data)
+		nsec = uint32(value >> 34)
+		sec = int64(value & 0x3ffffffff)
+	case 12:
+		nsec = binary.BigEndian.Uint32(data[0:4])
+		sec = int64(binary.BigEndian.Uint64(data[4:12]))
+	default:
+		return time.Time{}, fmt.Errorf("invalid timestamp length: %d", extLen)
+	}
+	if nsec >= uint32(time.Second) {
+		return time.Time{}, fmt.Errorf("invalid timestamp nanoseconds: %d", nsec)
+	}
+
+	return time.Unix(sec, int64(nsec)), nil
+}
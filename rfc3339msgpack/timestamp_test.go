@@ -0,0 +1,148 @@
+package rfc3339msgpack
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func mustHex(t *testing.T, input string) []byte {
+	t.Helper()
+	data, err := hex.DecodeString(input)
+	require.NoError(t, err)
+	return data
+}
+
+func TestTimestamp(t *testing.T) {
+	t.Run("encodes the smallest form", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			// timestamp 32
+			{"2023-04-01T12:30:00Z", "d6ff642823c8"},
+			// timestamp 64
+			{"2023-04-01T12:30:00.5Z", "d7ff77359400642823c8"},
+			// timestamp 96
+			{"1969-12-31T23:59:59.5Z", "c70cff1dcd6500ffffffffffffffff"},
+			{"2514-05-30T01:53:04Z", "c70cff000000000000000400000000"},
+		}
+
+		for _, test := range tests {
+			input := Timestamp{rfc3339.MustParseDateTimeString(test.input)}
+			data, err := msgpack.Marshal(input)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, hex.EncodeToString(data))
+
+			var found Timestamp
+			err = msgpack.Unmarshal(data, &found)
+			require.NoError(t, err)
+			assert.Equal(t, input, found)
+		}
+	})
+
+	t.Run("decodes to UTC", func(t *testing.T) {
+		input := Timestamp{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.005-04:00")}
+		data, err := msgpack.Marshal(input)
+		require.NoError(t, err)
+
+		var found Timestamp
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T12:30:00.005Z", found.ToString())
+	})
+
+	t.Run("interoperates with time.Time", func(t *testing.T) {
+		expected := time.Date(2023, 4, 1, 12, 30, 0, 123456789, time.UTC)
+		data, err := msgpack.Marshal(Timestamp{rfc3339.NewFromTime(expected)})
+		require.NoError(t, err)
+
+		var found time.Time
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.True(t, expected.Equal(found))
+
+		data, err = msgpack.Marshal(expected)
+		require.NoError(t, err)
+
+		var ts Timestamp
+		err = msgpack.Unmarshal(data, &ts)
+		require.NoError(t, err)
+		assert.True(t, expected.Equal(ts.Time))
+	})
+
+	t.Run("round trips zero value as nil", func(t *testing.T) {
+		data, err := msgpack.Marshal(Timestamp{})
+		require.NoError(t, err)
+		assert.Equal(t, "c0", hex.EncodeToString(data))
+
+		found := Timestamp{rfc3339.MustParseDateTimeString("2023-04-01T12:30:00Z")}
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.True(t, found.IsZero())
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"d60164282448", "timestamp must be extension type -1, got: 1"},
+			{"d5ff6428", "invalid timestamp length: 2"},
+			{"d7ffffffffff64282448", "invalid timestamp nanoseconds: 1073741823"},
+			{"b4323032332d30342d30315431323a33303a30305a", "msgpack: invalid code=b4 decoding ext len"},
+		}
+
+		for _, test := range tests {
+			var found Timestamp
+			err := msgpack.Unmarshal(mustHex(t, test.input), &found)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
+
+func TestOffsetTimestamp(t *testing.T) {
+	t.Run("preserves the offset", func(t *testing.T) {
+		input := OffsetTimestamp{rfc3339.MustParseDateTimeString("2023-04-01T08:30:00.005-04:00")}
+		data, err := msgpack.Marshal(input)
+		require.NoError(t, err)
+		assert.Equal(t, "92d7ff01312d00642823c8d1c7c0", hex.EncodeToString(data))
+
+		var found OffsetTimestamp
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("decodes zero offsets to UTC", func(t *testing.T) {
+		input := OffsetTimestamp{rfc3339.MustParseDateTimeString("2023-04-01T12:30:00Z")}
+		data, err := msgpack.Marshal(input)
+		require.NoError(t, err)
+
+		var found OffsetTimestamp
+		err = msgpack.Unmarshal(data, &found)
+		require.NoError(t, err)
+		assert.Equal(t, input, found)
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			expected string
+		}{
+			{"91d6ff64282448", "offset timestamp must be an array of 2 elements, got: 1"},
+			{"92d6ff64282448ce00015180", "offset timestamp offset out of range: 86400"},
+		}
+
+		for _, test := range tests {
+			var found OffsetTimestamp
+			err := msgpack.Unmarshal(mustHex(t, test.input), &found)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
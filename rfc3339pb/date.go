@@ -0,0 +1,49 @@
+package rfc3339pb
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+// ToDate converts an [rfc3339.FullDate] to a google.type.Date. An error is
+// returned if the year is outside the range supported by Date, 1 through
+// 9999.
+func ToDate(fd rfc3339.FullDate) (*date.Date, error) {
+	year, month, day := fd.Date()
+	if year < 1 || year > 9999 {
+		return nil, fmt.Errorf("full-date year out of date range: %d", year)
+	}
+
+	return &date.Date{
+		Year:  int32(year),
+		Month: int32(month),
+		Day:   int32(day),
+	}, nil
+}
+
+// FromDate converts a google.type.Date to an [rfc3339.FullDate]. Only full
+// dates can be converted; an error is returned if the Date is nil, any part
+// is unset (zero), or any part is out of range.
+func FromDate(d *date.Date) (rfc3339.FullDate, error) {
+	if d == nil {
+		return rfc3339.FullDate{}, errors.New("date is nil")
+	}
+
+	year, month, day := int(d.GetYear()), int(d.GetMonth()), int(d.GetDay())
+	if year < 1 || year > 9999 || month < 1 || month > 12 || day < 1 {
+		return rfc3339.FullDate{}, fmt.Errorf("invalid date: %04d-%02d-%02d", year, month, day)
+	}
+
+	// Reject days beyond the end of the month, e.g. February 30, rather than
+	// letting [time.Date] normalize them into the following month.
+	fd := rfc3339.NewFullDate(year, time.Month(month), day)
+	if fd.Day() != day {
+		return rfc3339.FullDate{}, fmt.Errorf("invalid date: %04d-%02d-%02d", year, month, day)
+	}
+
+	return fd, nil
+}
@@ -0,0 +1,64 @@
+package rfc3339pb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+func TestToDate(t *testing.T) {
+	t.Run("converts values", func(t *testing.T) {
+		d, err := ToDate(rfc3339.MustParseDateString("2023-04-01"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(2023), d.GetYear())
+		assert.Equal(t, int32(4), d.GetMonth())
+		assert.Equal(t, int32(1), d.GetDay())
+	})
+
+	t.Run("uses the date in the instance location", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T23:30:00-04:00")
+		d, err := ToDate(dt.ToFullDate())
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), d.GetDay())
+	})
+
+	t.Run("returns error for out of range year", func(t *testing.T) {
+		fd := rfc3339.FullDate{Time: time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)}
+		_, err := ToDate(fd)
+		assert.EqualError(t, err, "full-date year out of date range: 0")
+	})
+}
+
+func TestFromDate(t *testing.T) {
+	t.Run("converts values", func(t *testing.T) {
+		fd, err := FromDate(&date.Date{Year: 2023, Month: 4, Day: 1})
+		require.NoError(t, err)
+		assert.Equal(t, rfc3339.MustParseDateString("2023-04-01"), fd)
+	})
+
+	t.Run("returns error for nil date", func(t *testing.T) {
+		_, err := FromDate(nil)
+		assert.EqualError(t, err, "date is nil")
+	})
+
+	t.Run("returns error for partial and invalid dates", func(t *testing.T) {
+		tests := []struct {
+			input    *date.Date
+			expected string
+		}{
+			{&date.Date{Month: 4, Day: 1}, "invalid date: 0000-04-01"},
+			{&date.Date{Year: 2023, Day: 1}, "invalid date: 2023-00-01"},
+			{&date.Date{Year: 2023, Month: 4}, "invalid date: 2023-04-00"},
+			{&date.Date{Year: 2023, Month: 2, Day: 30}, "invalid date: 2023-02-30"},
+		}
+
+		for _, test := range tests {
+			_, err := FromDate(test.input)
+			assert.EqualError(t, err, test.expected)
+		}
+	})
+}
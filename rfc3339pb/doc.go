@@ -0,0 +1,6 @@
+// Package rfc3339pb converts between the rfc3339 types and their protocol
+// buffer counterparts: [rfc3339.DateTime] and google.protobuf.Timestamp, and
+// [rfc3339.FullDate] and google.type.Date. It is a separate module,
+// github.com/jsumners/go-rfc3339/rfc3339pb, so that the core module does not
+// require the protocol buffer dependencies.
+package rfc3339pb
@@ -0,0 +1,67 @@
+package rfc3339pb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jsumners/go-rfc3339"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrOffsetLoss is returned by [ToTimestamp] when the [rfc3339.DateTime] has
+// a UTC offset other than +00:00 and [AllowOffsetLoss] was not supplied.
+// A google.protobuf.Timestamp cannot represent an offset.
+var ErrOffsetLoss = errors.New("date-time offset would be lost")
+
+// Option configures a conversion to a protocol buffer type.
+type Option func(*options)
+
+type options struct {
+	allowOffsetLoss bool
+}
+
+// AllowOffsetLoss permits [ToTimestamp] to convert an [rfc3339.DateTime] that
+// has a UTC offset other than +00:00. The instant is preserved, but the
+// offset is discarded.
+func AllowOffsetLoss() Option {
+	return func(o *options) {
+		o.allowOffsetLoss = true
+	}
+}
+
+// ToTimestamp converts an [rfc3339.DateTime] to a google.protobuf.Timestamp.
+// An error is returned if the instant is outside the range supported by
+// Timestamp, 0001-01-01T00:00:00Z through 9999-12-31T23:59:59.999999999Z.
+// Unless [AllowOffsetLoss] is supplied, an [ErrOffsetLoss] error is returned
+// for instances that are not at the UTC (+00:00) offset.
+func ToTimestamp(dt rfc3339.DateTime, opts ...Option) (*timestamppb.Timestamp, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if _, offset := dt.Zone(); offset != 0 && !o.allowOffsetLoss {
+		return nil, fmt.Errorf("%w: %s", ErrOffsetLoss, dt.ToString())
+	}
+
+	ts := timestamppb.New(dt.Time)
+	if err := ts.CheckValid(); err != nil {
+		return nil, fmt.Errorf("date-time out of timestamp range: %s", dt.ToString())
+	}
+
+	return ts, nil
+}
+
+// FromTimestamp converts a google.protobuf.Timestamp to an
+// [rfc3339.DateTime] at the UTC (+00:00) offset. An error is returned if the
+// Timestamp is nil, or is not valid.
+func FromTimestamp(ts *timestamppb.Timestamp) (rfc3339.DateTime, error) {
+	if ts == nil {
+		return rfc3339.DateTime{}, errors.New("timestamp is nil")
+	}
+	if err := ts.CheckValid(); err != nil {
+		return rfc3339.DateTime{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	return rfc3339.NewFromTime(ts.AsTime()), nil
+}
@@ -0,0 +1,82 @@
+package rfc3339pb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToTimestamp(t *testing.T) {
+	t.Run("converts UTC values", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T12:30:00.123456789Z")
+		ts, err := ToTimestamp(dt)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1680352200), ts.GetSeconds())
+		assert.Equal(t, int32(123456789), ts.GetNanos())
+	})
+
+	t.Run("returns error for offset loss", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T08:30:00-04:00")
+		_, err := ToTimestamp(dt)
+		assert.ErrorIs(t, err, ErrOffsetLoss)
+		assert.EqualError(t, err, "date-time offset would be lost: 2023-04-01T08:30:00-04:00")
+	})
+
+	t.Run("discards offset when allowed", func(t *testing.T) {
+		dt := rfc3339.MustParseDateTimeString("2023-04-01T08:30:00-04:00")
+		ts, err := ToTimestamp(dt, AllowOffsetLoss())
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T12:30:00Z", ts.AsTime().Format(time.RFC3339))
+	})
+
+	t.Run("returns error for out of range values", func(t *testing.T) {
+		inputs := []string{
+			"0000-12-31T23:59:59Z",
+			"0001-01-01T00:00:00+00:01",
+			"9999-12-31T23:59:59-00:01",
+		}
+
+		for _, input := range inputs {
+			dt := rfc3339.MustParseDateTimeString(input)
+			_, err := ToTimestamp(dt, AllowOffsetLoss())
+			assert.EqualError(t, err, "date-time out of timestamp range: "+input)
+		}
+	})
+}
+
+func TestFromTimestamp(t *testing.T) {
+	t.Run("converts to UTC values", func(t *testing.T) {
+		ts := &timestamppb.Timestamp{Seconds: 1680352200, Nanos: 5000000}
+		dt, err := FromTimestamp(ts)
+		require.NoError(t, err)
+		assert.Equal(t, rfc3339.MustParseDateTimeString("2023-04-01T12:30:00.005Z"), dt)
+	})
+
+	t.Run("round trips range limits", func(t *testing.T) {
+		inputs := []string{"0001-01-01T00:00:00Z", "9999-12-31T23:59:59.999999999Z"}
+
+		for _, input := range inputs {
+			expected := rfc3339.MustParseDateTimeString(input)
+			ts, err := ToTimestamp(expected)
+			require.NoError(t, err)
+
+			found, err := FromTimestamp(ts)
+			require.NoError(t, err)
+			assert.Equal(t, expected, found)
+		}
+	})
+
+	t.Run("returns error for nil timestamp", func(t *testing.T) {
+		_, err := FromTimestamp(nil)
+		assert.EqualError(t, err, "timestamp is nil")
+	})
+
+	t.Run("returns error for invalid timestamp", func(t *testing.T) {
+		_, err := FromTimestamp(&timestamppb.Timestamp{Seconds: 253402300800})
+		assert.ErrorContains(t, err, "invalid timestamp:")
+	})
+}
@@ -0,0 +1,220 @@
+package rfc3339test
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsumners/go-rfc3339"
+)
+
+// Parser is a named RFC 3339 date-time parser.
+type Parser struct {
+	Name  string
+	Parse func(input string) (time.Time, error)
+}
+
+// Package is the rfc3339 package's date-time parser,
+// [rfc3339.NewDateTimeFromString].
+var Package = Parser{
+	Name: "rfc3339",
+	Parse: func(input string) (time.Time, error) {
+		dt, err := rfc3339.NewDateTimeFromString(input)
+		return dt.Time, err
+	},
+}
+
+// TimeParse is [time.Parse] with the [time.RFC3339Nano] layout.
+var TimeParse = Parser{
+	Name: "time.Parse",
+	Parse: func(input string) (time.Time, error) {
+		return time.Parse(time.RFC3339Nano, input)
+	},
+}
+
+// TimeUnmarshalText is [time.Time.UnmarshalText], which is stricter than
+// [time.Parse] about the range of the UTC offset.
+var TimeUnmarshalText = Parser{
+	Name: "time.UnmarshalText",
+	Parse: func(input string) (time.Time, error) {
+		var t time.Time
+		err := t.UnmarshalText([]byte(input))
+		return t, err
+	},
+}
+
+// Difference classifies how two parsers handle an input.
+type Difference int
+
+const (
+	// Agree means both parsers reject the input, or both accept it with
+	// the same instant and UTC offset.
+	Agree Difference = iota
+	// OnlyFirst means only the first parser accepts the input.
+	OnlyFirst
+	// OnlySecond means only the second parser accepts the input.
+	OnlySecond
+	// DifferentValues means both parsers accept the input, but with a
+	// different instant or UTC offset.
+	DifferentValues
+)
+
+func (d Difference) String() string {
+	switch d {
+	case Agree:
+		return "agree"
+	case OnlyFirst:
+		return "only-first"
+	case OnlySecond:
+		return "only-second"
+	case DifferentValues:
+		return "different-values"
+	default:
+		return fmt.Sprintf("Difference(%d)", int(d))
+	}
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (d Difference) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// Outcome is the result of parsing an input with a [Parser]. Accepted
+// inputs have a Value, and rejected inputs have an Error.
+type Outcome struct {
+	Value string `json:"value,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+func outcomeOf(p Parser, input string) (Outcome, time.Time, bool) {
+	t, err := p.Parse(input)
+	if err != nil {
+		return Outcome{Error: err.Error()}, time.Time{}, false
+	}
+	return Outcome{Value: t.Format(time.RFC3339Nano)}, t, true
+}
+
+func (o Outcome) String() string {
+	if o.Error != "" {
+		return "rejects: " + o.Error
+	}
+	return "accepts as " + o.Value
+}
+
+// Disagreement is an input on which two parsers disagree.
+type Disagreement struct {
+	Input      string     `json:"input"`
+	Difference Difference `json:"difference"`
+	First      Outcome    `json:"first"`
+	Second     Outcome    `json:"second"`
+}
+
+// Report is the result of [Compare].
+type Report struct {
+	First         string         `json:"first"`
+	Second        string         `json:"second"`
+	Inputs        int            `json:"inputs"`
+	Disagreements []Disagreement `json:"disagreements"`
+}
+
+// Compare runs every input through both parsers. The report lists the
+// disagreements in the order of the inputs.
+func Compare(first Parser, second Parser, inputs []string) Report {
+	report := Report{
+		First:         first.Name,
+		Second:        second.Name,
+		Inputs:        len(inputs),
+		Disagreements: []Disagreement{},
+	}
+
+	for _, input := range inputs {
+		firstOutcome, firstTime, firstOK := outcomeOf(first, input)
+		secondOutcome, secondTime, secondOK := outcomeOf(second, input)
+
+		difference := Agree
+		switch {
+		case firstOK && !secondOK:
+			difference = OnlyFirst
+		case !firstOK && secondOK:
+			difference = OnlySecond
+		case firstOK && secondOK && !sameInstant(firstTime, secondTime):
+			difference = DifferentValues
+		}
+		if difference == Agree {
+			continue
+		}
+
+		report.Disagreements = append(report.Disagreements, Disagreement{
+			Input:      input,
+			Difference: difference,
+			First:      firstOutcome,
+			Second:     secondOutcome,
+		})
+	}
+
+	return report
+}
+
+// sameInstant reports whether two times are the same instant at the same
+// UTC offset.
+func sameInstant(a time.Time, b time.Time) bool {
+	_, aOffset := a.Zone()
+	_, bOffset := b.Zone()
+	return a.Equal(b) && aOffset == bOffset
+}
+
+// String writes the report as text, one disagreement per line.
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(
+		&b, "%s and %s disagree on %d of %d inputs\n",
+		r.First, r.Second, len(r.Disagreements), r.Inputs,
+	)
+	for _, d := range r.Disagreements {
+		fmt.Fprintf(
+			&b, "%s (%s): %s %s; %s %s\n",
+			strconv.Quote(d.Input), d.Difference, r.First, d.First, r.Second, d.Second,
+		)
+	}
+	return b.String()
+}
+
+// Expect fails the test for every disagreement of the report that is not in
+// `expected`, for every expected input on which the parsers agree, and for
+// every input whose difference is not the expected one. The keys of
+// `expected` are inputs.
+func Expect(tb testing.TB, report Report, expected map[string]Difference) {
+	tb.Helper()
+
+	found := map[string]bool{}
+	for _, d := range report.Disagreements {
+		found[d.Input] = true
+		want, ok := expected[d.Input]
+		switch {
+		case !ok:
+			tb.Errorf(
+				"unexpected disagreement on %q (%s): %s %s; %s %s",
+				d.Input, d.Difference, report.First, d.First, report.Second, d.Second,
+			)
+		case want != d.Difference:
+			tb.Errorf(
+				"expected %s on %q, got %s: %s %s; %s %s",
+				want, d.Input, d.Difference, report.First, d.First, report.Second, d.Second,
+			)
+		}
+	}
+
+	inputs := make([]string, 0, len(expected))
+	for input := range expected {
+		inputs = append(inputs, input)
+	}
+	sort.Strings(inputs)
+	for _, input := range inputs {
+		if want := expected[input]; !found[input] && want != Agree {
+			tb.Errorf("expected %s on %q, but %s and %s agree", want, input, report.First, report.Second)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package rfc3339test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeParser parses the inputs of a table.
+func fakeParser(name string, values map[string]string) Parser {
+	return Parser{
+		Name: name,
+		Parse: func(input string) (time.Time, error) {
+			value, ok := values[input]
+			if !ok {
+				return time.Time{}, errors.New("rejected")
+			}
+			return time.Parse(time.RFC3339Nano, value)
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	first := fakeParser("first", map[string]string{
+		"both":      "2023-04-04T12:30:00Z",
+		"offset":    "2023-04-04T12:30:00Z",
+		"instant":   "2023-04-04T12:30:00Z",
+		"only-mine": "2023-04-04T12:30:00Z",
+	})
+	second := fakeParser("second", map[string]string{
+		"both":       "2023-04-04T12:30:00Z",
+		"offset":     "2023-04-04T08:30:00-04:00",
+		"instant":    "2023-04-04T12:30:01Z",
+		"only-yours": "2023-04-04T12:30:00Z",
+	})
+
+	report := Compare(first, second, []string{"both", "neither", "offset", "instant", "only-mine", "only-yours"})
+
+	t.Run("classifies disagreements", func(t *testing.T) {
+		assert.Equal(t, Report{
+			First:  "first",
+			Second: "second",
+			Inputs: 6,
+			Disagreements: []Disagreement{
+				{
+					Input:      "offset",
+					Difference: DifferentValues,
+					First:      Outcome{Value: "2023-04-04T12:30:00Z"},
+					Second:     Outcome{Value: "2023-04-04T08:30:00-04:00"},
+				},
+				{
+					Input:      "instant",
+					Difference: DifferentValues,
+					First:      Outcome{Value: "2023-04-04T12:30:00Z"},
+					Second:     Outcome{Value: "2023-04-04T12:30:01Z"},
+				},
+				{
+					Input:      "only-mine",
+					Difference: OnlyFirst,
+					First:      Outcome{Value: "2023-04-04T12:30:00Z"},
+					Second:     Outcome{Error: "rejected"},
+				},
+				{
+					Input:      "only-yours",
+					Difference: OnlySecond,
+					First:      Outcome{Error: "rejected"},
+					Second:     Outcome{Value: "2023-04-04T12:30:00Z"},
+				},
+			},
+		}, report)
+	})
+
+	t.Run("writes text", func(t *testing.T) {
+		expected := "first and second disagree on 4 of 6 inputs\n" +
+			`"offset" (different-values): first accepts as 2023-04-04T12:30:00Z; second accepts as 2023-04-04T08:30:00-04:00` + "\n" +
+			`"instant" (different-values): first accepts as 2023-04-04T12:30:00Z; second accepts as 2023-04-04T12:30:01Z` + "\n" +
+			`"only-mine" (only-first): first accepts as 2023-04-04T12:30:00Z; second rejects: rejected` + "\n" +
+			`"only-yours" (only-second): first rejects: rejected; second accepts as 2023-04-04T12:30:00Z` + "\n"
+		assert.Equal(t, expected, report.String())
+	})
+
+	t.Run("writes json", func(t *testing.T) {
+		data, err := json.Marshal(Compare(first, second, []string{"both", "only-mine"}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"first": "first",
+			"second": "second",
+			"inputs": 2,
+			"disagreements": [{
+				"input": "only-mine",
+				"difference": "only-first",
+				"first": {"value": "2023-04-04T12:30:00Z"},
+				"second": {"error": "rejected"}
+			}]
+		}`, string(data))
+	})
+
+	t.Run("reports agreement", func(t *testing.T) {
+		report := Compare(first, second, []string{"both", "neither"})
+		assert.Empty(t, report.Disagreements)
+		assert.Equal(t, "first and second disagree on 0 of 2 inputs\n", report.String())
+	})
+}
+
+func TestDifference_String(t *testing.T) {
+	assert.Equal(t, "agree", Agree.String())
+	assert.Equal(t, "only-first", OnlyFirst.String())
+	assert.Equal(t, "only-second", OnlySecond.String())
+	assert.Equal(t, "different-values", DifferentValues.String())
+	assert.Equal(t, "Difference(9)", Difference(9).String())
+}
+
+// recorder is a [testing.TB] that records the errors reported to it.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestExpect(t *testing.T) {
+	report := Report{
+		First:  "first",
+		Second: "second",
+		Inputs: 3,
+		Disagreements: []Disagreement{
+			{Input: "a", Difference: OnlyFirst, First: Outcome{Value: "v"}, Second: Outcome{Error: "e"}},
+			{Input: "b", Difference: OnlySecond, First: Outcome{Error: "e"}, Second: Outcome{Value: "v"}},
+		},
+	}
+
+	t.Run("passes when the expectations match", func(t *testing.T) {
+		r := &recorder{}
+		Expect(r, report, map[string]Difference{"a": OnlyFirst, "b": OnlySecond, "c": Agree})
+		assert.Empty(t, r.errors)
+	})
+
+	t.Run("reports mismatches", func(t *testing.T) {
+		r := &recorder{}
+		Expect(r, report, map[string]Difference{"b": DifferentValues, "d": OnlyFirst, "c": OnlySecond})
+		assert.Equal(t, []string{
+			`unexpected disagreement on "a" (only-first): first accepts as v; second rejects: e`,
+			`expected different-values on "b", got only-second: first rejects: e; second accepts as v`,
+			`expected only-second on "c", but first and second agree`,
+			`expected only-first on "d", but first and second agree`,
+		}, r.errors)
+	})
+}
@@ -0,0 +1,73 @@
+package rfc3339test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Verdicts of a corpus [Case].
+const (
+	// VerdictValid marks a valid RFC 3339 string.
+	VerdictValid = "valid"
+	// VerdictInvalid marks a string that is not RFC 3339.
+	VerdictInvalid = "invalid"
+	// VerdictLenient marks a string that is not valid RFC 3339 because a
+	// field is out of range, but that the rfc3339 package accepts.
+	VerdictLenient = "lenient"
+)
+
+// Case is an entry of a corpus.
+type Case struct {
+	Line    int
+	Verdict string
+	Input   string
+}
+
+// ReadCorpus reads a corpus. Each line is a verdict followed by the input.
+// Inputs that start with a double quote are Go quoted strings. Blank lines
+// and lines starting with `#` are ignored.
+func ReadCorpus(r io.Reader) ([]Case, error) {
+	var cases []Case
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line += 1 {
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		verdict, input, _ := strings.Cut(text, " ")
+		switch verdict {
+		case VerdictValid, VerdictInvalid, VerdictLenient:
+		default:
+			return nil, fmt.Errorf("line %d: unknown verdict `%s`", line, verdict)
+		}
+
+		input = strings.TrimLeft(input, " ")
+		if strings.HasPrefix(input, `"`) {
+			unquoted, err := strconv.Unquote(input)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			input = unquoted
+		}
+
+		cases = append(cases, Case{Line: line, Verdict: verdict, Input: input})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return cases, nil
+}
+
+// Inputs returns the inputs of the cases.
+func Inputs(cases []Case) []string {
+	inputs := make([]string, 0, len(cases))
+	for _, c := range cases {
+		inputs = append(inputs, c.Input)
+	}
+	return inputs
+}
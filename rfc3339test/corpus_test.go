@@ -0,0 +1,42 @@
+package rfc3339test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCorpus(t *testing.T) {
+	t.Run("reads cases", func(t *testing.T) {
+		input := strings.Join([]string{
+			"# A comment.",
+			"",
+			"valid   2023-04-04T12:30:00Z",
+			`invalid ""`,
+			`invalid " 2023-04-04T12:30:00Z"`,
+			"lenient 2023-13-01T00:00:00Z",
+		}, "\n")
+
+		found, err := ReadCorpus(strings.NewReader(input))
+		require.NoError(t, err)
+		assert.Equal(t, []Case{
+			{Line: 3, Verdict: VerdictValid, Input: "2023-04-04T12:30:00Z"},
+			{Line: 4, Verdict: VerdictInvalid, Input: ""},
+			{Line: 5, Verdict: VerdictInvalid, Input: " 2023-04-04T12:30:00Z"},
+			{Line: 6, Verdict: VerdictLenient, Input: "2023-13-01T00:00:00Z"},
+		}, found)
+		assert.Equal(t, []string{"2023-04-04T12:30:00Z", "", " 2023-04-04T12:30:00Z", "2023-13-01T00:00:00Z"}, Inputs(found))
+	})
+
+	t.Run("rejects unknown verdicts", func(t *testing.T) {
+		_, err := ReadCorpus(strings.NewReader("valid 2023-04-04\nmaybe 2023-04-04"))
+		assert.EqualError(t, err, "line 2: unknown verdict `maybe`")
+	})
+
+	t.Run("rejects bad quoting", func(t *testing.T) {
+		_, err := ReadCorpus(strings.NewReader(`invalid "2023-04-04`))
+		assert.ErrorContains(t, err, "line 1: invalid syntax")
+	})
+}
@@ -0,0 +1,92 @@
+package rfc3339test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// intentionalDifferences are the inputs of the conformance corpus, and of
+// extraInputs, on which the rfc3339 package intentionally differs from the
+// standard library parsers.
+var intentionalDifferences = map[string]Difference{
+	// RFC 3339 allows a leap second, which time.Date normalizes into the
+	// next minute. The standard library rejects second 60.
+	"1990-12-31T23:59:60Z":      OnlyFirst,
+	"1990-12-31T15:59:60-08:00": OnlyFirst,
+
+	// RFC 3339 allows the `T` and `Z` to be lower case. The standard
+	// library layouts only match upper case.
+	"2023-04-04t12:30:00z": OnlyFirst,
+
+	// The rfc3339 package only checks the number of digits of each field,
+	// and lets time.Date normalize out of range values, e.g. hour 24 into
+	// midnight of the next day. The standard library checks the ranges.
+	"2023-00-01T00:00:00Z":      OnlyFirst,
+	"2023-13-01T00:00:00Z":      OnlyFirst,
+	"2023-04-00T00:00:00Z":      OnlyFirst,
+	"2023-02-29T00:00:00Z":      OnlyFirst,
+	"2023-04-31T00:00:00Z":      OnlyFirst,
+	"2023-04-04T24:00:00Z":      OnlyFirst,
+	"2023-04-04T12:60:00Z":      OnlyFirst,
+	"2023-04-04T12:30:61Z":      OnlyFirst,
+	"2023-04-04T12:30:00-99:99": OnlyFirst,
+
+	// The standard library accepts a comma before the fractional seconds,
+	// and single digit hours, neither of which are RFC 3339.
+	"2023-04-04T12:30:00,5Z": OnlySecond,
+	"2023-04-04T1:30:00Z":    OnlySecond,
+
+	// Both accept offset hours above 23, and offset minute 60, which are
+	// not RFC 3339. They are listed to document the agreement.
+	"2023-04-04T12:30:00+24:00": Agree,
+	"2023-04-04T12:30:00+23:60": Agree,
+
+	// Both accept single digit, and more than nine digit, fractional
+	// seconds; the extra digits are truncated.
+	"2023-04-04T12:30:00.5Z":          Agree,
+	"2023-04-04T12:30:00.1234567891Z": Agree,
+}
+
+// extraInputs are compared in addition to the conformance corpus.
+var extraInputs = []string{
+	"2023-04-04T12:30:00.5Z",
+	"2023-04-04T12:30:00.1234567891Z",
+	"2023-04-04T12:30:00.5+05:30",
+	"2023-04-04T12:30:00,5Z",
+	"2023-04-04T1:30:00Z",
+	"2023-04-04T12:30:00+24:00",
+}
+
+func conformanceInputs(t *testing.T) []string {
+	t.Helper()
+	file, err := os.Open(filepath.Join("..", "testdata", "conformance", "date-time.txt"))
+	require.NoError(t, err)
+	defer file.Close()
+
+	cases, err := ReadCorpus(file)
+	require.NoError(t, err)
+	return append(Inputs(cases), extraInputs...)
+}
+
+func TestDifferential(t *testing.T) {
+	inputs := conformanceInputs(t)
+
+	t.Run("time.Parse", func(t *testing.T) {
+		report := Compare(Package, TimeParse, inputs)
+		Expect(t, report, intentionalDifferences)
+		if t.Failed() {
+			t.Log(report)
+		}
+	})
+
+	t.Run("time.UnmarshalText", func(t *testing.T) {
+		report := Compare(Package, TimeUnmarshalText, inputs)
+		Expect(t, report, intentionalDifferences)
+		if t.Failed() {
+			t.Log(report)
+		}
+	})
+}
@@ -0,0 +1,15 @@
+// Package rfc3339test provides a differential testing harness that runs
+// corpora of RFC 3339 strings through two parsers and reports where they
+// disagree.
+//
+// A [Parser] is any function that turns a string into a [time.Time]. The
+// package provides [Package], the rfc3339 date-time parser, along with
+// [TimeParse] and [TimeUnmarshalText], the two standard library parsers.
+// [Compare] produces a [Report] of every input on which the parsers
+// disagree: because only one of them accepts it, or because they accept it
+// with different values. Reports can be printed, encoded as JSON, and checked
+// against a table of expected differences with [Expect].
+//
+// [ReadCorpus] reads corpora in the format of the module's
+// testdata/conformance files.
+package rfc3339test
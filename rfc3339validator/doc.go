@@ -0,0 +1,19 @@
+// Package rfc3339validator provides RFC 3339 validation tags for
+// github.com/go-playground/validator/v10. [Register] adds the following
+// tags to a validator instance:
+//
+//   - `rfc3339`: the field is an RFC 3339 date-time string.
+//   - `rfc3339date`: the field is an RFC 3339 full-date string.
+//   - `rfc3339_after=<field>`: the field is later than the named sibling
+//     field, e.g. `validate:"rfc3339_after=Start"` on an `End` field.
+//   - `rfc3339_before_now`: the field is earlier than the current time.
+//   - `rfc3339_max_age=<duration>`: the field is no further in the past than
+//     the [time.ParseDuration] formatted duration, e.g. `rfc3339_max_age=72h`.
+//
+// Each tag works on string fields, which are rejected when a field is out
+// of the ranges of RFC 3339 section 5.7, e.g. 2023-02-30, and directly on
+// [rfc3339.DateTime], [rfc3339.FullDate], and [time.Time] fields, as well as
+// the wrapper types of the other rfc3339 subpackages. Full-date values
+// compare as midnight UTC. Typed fields always satisfy the `rfc3339` and
+// `rfc3339date` tags; combine them with `required` to reject zero values.
+package rfc3339validator
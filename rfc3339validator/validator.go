@@ -0,0 +1,191 @@
+package rfc3339validator
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jsumners/go-rfc3339"
+)
+
+// Tag names registered by [Register].
+const (
+	TagDateTime  = "rfc3339"
+	TagDate      = "rfc3339date"
+	TagAfter     = "rfc3339_after"
+	TagBeforeNow = "rfc3339_before_now"
+	TagMaxAge    = "rfc3339_max_age"
+)
+
+type options struct {
+	now func() time.Time
+}
+
+// Option configures [Register].
+type Option func(*options)
+
+// WithNow sets the function that provides the current time for the
+// `rfc3339_before_now` and `rfc3339_max_age` tags. The default is
+// [time.Now].
+func WithNow(now func() time.Time) Option {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+// WithClock sets the [rfc3339.Clock] that provides the current time for the
+// `rfc3339_before_now` and `rfc3339_max_age` tags. It is equivalent to
+// passing the clock's Now method to [WithNow].
+func WithClock(clock rfc3339.Clock) Option {
+	return WithNow(clock.Now)
+}
+
+// Register adds the RFC 3339 tags to the validator.
+func Register(v *validator.Validate, opts ...Option) error {
+	o := options{now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	validations := map[string]validator.Func{
+		TagDateTime:  isDateTime,
+		TagDate:      isDate,
+		TagAfter:     isAfter,
+		TagBeforeNow: beforeNow(o.now),
+		TagMaxAge:    maxAge(o.now),
+	}
+	for tag, fn := range validations {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isDateTime(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() == reflect.String {
+		return rfc3339.ValidateDateTimeString(field.String()) == nil
+	}
+	_, ok := timeOf(field)
+	return ok
+}
+
+func isDate(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() == reflect.String {
+		return rfc3339.ValidateFullDateString(field.String()) == nil
+	}
+	_, ok := timeOf(field)
+	return ok
+}
+
+func isAfter(fl validator.FieldLevel) bool {
+	current, ok := timeOf(fl.Field())
+	if !ok {
+		return false
+	}
+
+	other, _, _, found := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param())
+	if !found {
+		panic(fmt.Sprintf("%s: field %s not found", TagAfter, fl.Param()))
+	}
+	if isEmpty(other) {
+		return true
+	}
+
+	before, ok := timeOf(other)
+	if !ok {
+		return false
+	}
+	return current.After(before)
+}
+
+func beforeNow(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		current, ok := timeOf(fl.Field())
+		if !ok {
+			return false
+		}
+		return current.Before(now())
+	}
+}
+
+func maxAge(now func() time.Time) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		age, err := time.ParseDuration(fl.Param())
+		if err != nil {
+			panic(fmt.Sprintf("%s: %s", TagMaxAge, err))
+		}
+
+		current, ok := timeOf(fl.Field())
+		if !ok {
+			return false
+		}
+		return now().Sub(current) <= age
+	}
+}
+
+// isEmpty reports whether a field value is an empty string, a nil pointer,
+// or a zero time.
+func isEmpty(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.String:
+		return field.String() == ""
+	case reflect.Pointer, reflect.Interface:
+		return field.IsNil()
+	}
+
+	t, ok := timeOf(field)
+	return ok && t.IsZero()
+}
+
+// timeOf converts a field value to a [time.Time]. Strings are parsed as an
+// RFC 3339 date-time, or else a full-date, whose fields are in range. Struct types that embed one of
+// the supported types, such as the wrappers of the other subpackages, are
+// unwrapped.
+func timeOf(field reflect.Value) (time.Time, bool) {
+	for field.Kind() == reflect.Pointer || field.Kind() == reflect.Interface {
+		if field.IsNil() {
+			return time.Time{}, false
+		}
+		field = field.Elem()
+	}
+	if !field.IsValid() || !field.CanInterface() {
+		return time.Time{}, false
+	}
+
+	switch v := field.Interface().(type) {
+	case string:
+		if rfc3339.ValidateDateTimeString(v) == nil {
+			return rfc3339.MustParseDateTimeString(v).Time, true
+		}
+		if rfc3339.ValidateFullDateString(v) == nil {
+			return rfc3339.MustParseDateString(v).Time, true
+		}
+		return time.Time{}, false
+	case rfc3339.DateTime:
+		return v.Time, true
+	case rfc3339.FullDate:
+		return v.Time, true
+	case time.Time:
+		return v, true
+	}
+
+	if field.Kind() == reflect.Struct {
+		for i := 0; i < field.NumField(); i += 1 {
+			if !field.Type().Field(i).Anonymous {
+				continue
+			}
+			if t, ok := timeOf(field.Field(i)); ok {
+				return t, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
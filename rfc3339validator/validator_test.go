@@ -0,0 +1,244 @@
+package rfc3339validator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jsumners/go-rfc3339"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// wrappedDateTime embeds an [rfc3339.DateTime], as the wrapper types of the
+// other rfc3339 subpackages do.
+type wrappedDateTime struct {
+	rfc3339.DateTime
+}
+
+var fixedNow = time.Date(2023, time.April, 4, 12, 0, 0, 0, time.UTC)
+
+func newValidator(t *testing.T) *validator.Validate {
+	t.Helper()
+	v := validator.New(validator.WithRequiredStructEnabled())
+	err := Register(v, WithNow(func() time.Time { return fixedNow }))
+	require.NoError(t, err)
+	return v
+}
+
+// failedTags returns the namespace and tag of each failed validation.
+func failedTags(t *testing.T, err error) map[string]string {
+	t.Helper()
+	found := map[string]string{}
+	if err == nil {
+		return found
+	}
+
+	var validationErrors validator.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors), err.Error())
+	for _, fieldErr := range validationErrors {
+		found[fieldErr.Namespace()] = fieldErr.Tag()
+	}
+	return found
+}
+
+func TestFormatTags(t *testing.T) {
+	type request struct {
+		Created  string           `validate:"rfc3339"`
+		Due      string           `validate:"rfc3339date"`
+		Optional string           `validate:"omitempty,rfc3339"`
+		Typed    rfc3339.DateTime `validate:"required,rfc3339"`
+		Day      rfc3339.FullDate `validate:"rfc3339date"`
+		Pointer  *string          `validate:"omitnil,rfc3339"`
+	}
+	v := newValidator(t)
+
+	t.Run("accepts valid values", func(t *testing.T) {
+		pointer := "2023-04-04T12:30:00Z"
+		err := v.Struct(request{
+			Created: "2023-04-04T12:30:00-04:00",
+			Due:     "2023-04-04",
+			Typed:   rfc3339.MustParseDateTimeString("2023-04-04T12:30:00Z"),
+			Pointer: &pointer,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		pointer := "yesterday"
+		err := v.Struct(request{
+			Created:  "2023-04-04 12:30:00",
+			Due:      "2023-04-04T00:00:00Z",
+			Optional: "2023-04-04",
+			Pointer:  &pointer,
+		})
+		assert.Equal(t, map[string]string{
+			"request.Created":  "rfc3339",
+			"request.Due":      "rfc3339date",
+			"request.Optional": "rfc3339",
+			"request.Typed":    "required",
+			"request.Pointer":  "rfc3339",
+		}, failedTags(t, err))
+	})
+
+	t.Run("rejects out of range fields", func(t *testing.T) {
+		err := v.Struct(request{
+			Created: "2023-04-04T24:00:00Z",
+			Due:     "2023-02-30",
+			Typed:   rfc3339.MustParseDateTimeString("2023-04-04T12:30:00Z"),
+		})
+		assert.Equal(t, map[string]string{
+			"request.Created": "rfc3339",
+			"request.Due":     "rfc3339date",
+		}, failedTags(t, err))
+
+		assert.Error(t, v.Var("2023-01-01T00:00:00+24:00", "rfc3339"))
+		assert.Error(t, v.Var("2023-04-04T12:30:60Z", "rfc3339"))
+		assert.Error(t, v.Var("2023-13-01", "rfc3339date"))
+		assert.NoError(t, v.Var("1990-12-31T23:59:60Z", "rfc3339"))
+	})
+
+	t.Run("validates variables", func(t *testing.T) {
+		assert.NoError(t, v.Var("2023-04-04T12:30:00Z", "rfc3339"))
+		assert.Error(t, v.Var("2023-04-04", "rfc3339"))
+		assert.NoError(t, v.Var("2023-04-04", "rfc3339date"))
+	})
+}
+
+func TestAfterTag(t *testing.T) {
+	type stringRange struct {
+		Start string `validate:"required,rfc3339"`
+		End   string `validate:"required,rfc3339,rfc3339_after=Start"`
+	}
+	type typedRange struct {
+		Start rfc3339.DateTime `validate:"required"`
+		End   rfc3339.DateTime `validate:"rfc3339_after=Start"`
+	}
+	type mixedRange struct {
+		Start rfc3339.FullDate
+		End   string          `validate:"omitempty,rfc3339_after=Start"`
+		Until wrappedDateTime `validate:"rfc3339_after=End"`
+	}
+	v := newValidator(t)
+
+	t.Run("string fields", func(t *testing.T) {
+		err := v.Struct(stringRange{Start: "2023-04-04T12:00:00Z", End: "2023-04-04T08:30:00-04:00"})
+		assert.NoError(t, err)
+
+		err = v.Struct(stringRange{Start: "2023-04-04T12:00:00Z", End: "2023-04-04T08:00:00-04:00"})
+		assert.Equal(t, map[string]string{"stringRange.End": "rfc3339_after"}, failedTags(t, err))
+
+		err = v.Struct(stringRange{Start: "bad", End: "2023-04-04T08:00:00-04:00"})
+		assert.Equal(t, map[string]string{
+			"stringRange.Start": "rfc3339",
+			"stringRange.End":   "rfc3339_after",
+		}, failedTags(t, err))
+	})
+
+	t.Run("typed fields", func(t *testing.T) {
+		start := rfc3339.MustParseDateTimeString("2023-04-04T12:00:00Z")
+		err := v.Struct(typedRange{Start: start, End: rfc3339.NewFromTime(start.Add(time.Nanosecond))})
+		assert.NoError(t, err)
+
+		err = v.Struct(typedRange{Start: start, End: start})
+		assert.Equal(t, map[string]string{"typedRange.End": "rfc3339_after"}, failedTags(t, err))
+	})
+
+	t.Run("mixed fields", func(t *testing.T) {
+		err := v.Struct(mixedRange{
+			Start: rfc3339.MustParseDateString("2023-04-04"),
+			End:   "2023-04-05",
+			Until: wrappedDateTime{DateTime: rfc3339.MustParseDateTimeString("2023-04-05T00:00:01Z")},
+		})
+		assert.NoError(t, err)
+
+		err = v.Struct(mixedRange{
+			Start: rfc3339.MustParseDateString("2023-04-04"),
+			End:   "2023-04-03T23:59:59Z",
+			Until: wrappedDateTime{DateTime: rfc3339.MustParseDateTimeString("2023-04-03T23:59:59Z")},
+		})
+		assert.Equal(t, map[string]string{
+			"mixedRange.End":   "rfc3339_after",
+			"mixedRange.Until": "rfc3339_after",
+		}, failedTags(t, err))
+	})
+
+	t.Run("passes when the other field is empty", func(t *testing.T) {
+		err := v.Struct(mixedRange{
+			Until: wrappedDateTime{DateTime: rfc3339.MustParseDateTimeString("2023-04-03T23:59:59Z")},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("panics for unknown fields", func(t *testing.T) {
+		type unknown struct {
+			End string `validate:"rfc3339_after=Begin"`
+		}
+		assert.PanicsWithValue(t, "rfc3339_after: field Begin not found", func() {
+			v.Struct(unknown{End: "2023-04-04"})
+		})
+	})
+}
+
+func TestNowTags(t *testing.T) {
+	type event struct {
+		Seen      string           `validate:"omitempty,rfc3339_before_now"`
+		Heartbeat rfc3339.DateTime `validate:"omitempty,rfc3339_max_age=1h30m"`
+		Birthday  rfc3339.FullDate `validate:"omitempty,rfc3339_before_now"`
+		Updated   time.Time        `validate:"omitempty,rfc3339_max_age=24h"`
+	}
+	v := newValidator(t)
+
+	t.Run("accepts values within range", func(t *testing.T) {
+		err := v.Struct(event{
+			Seen:      "2023-04-04T11:59:59Z",
+			Heartbeat: rfc3339.MustParseDateTimeString("2023-04-04T06:30:00-04:00"),
+			Birthday:  rfc3339.MustParseDateString("2023-04-04"),
+			Updated:   fixedNow.Add(-24 * time.Hour),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects values out of range", func(t *testing.T) {
+		err := v.Struct(event{
+			Seen:      "2023-04-04T12:00:00Z",
+			Heartbeat: rfc3339.MustParseDateTimeString("2023-04-04T06:29:59-04:00"),
+			Birthday:  rfc3339.MustParseDateString("2023-04-05"),
+			Updated:   fixedNow.Add(-25 * time.Hour),
+		})
+		assert.Equal(t, map[string]string{
+			"event.Seen":      "rfc3339_before_now",
+			"event.Heartbeat": "rfc3339_max_age",
+			"event.Birthday":  "rfc3339_before_now",
+			"event.Updated":   "rfc3339_max_age",
+		}, failedTags(t, err))
+	})
+
+	t.Run("rejects unparseable strings", func(t *testing.T) {
+		err := v.Var("last week", "rfc3339_before_now")
+		assert.Error(t, err)
+	})
+
+	t.Run("panics for invalid durations", func(t *testing.T) {
+		assert.Panics(t, func() {
+			v.Var("2023-04-04", "rfc3339_max_age=3d")
+		})
+	})
+
+	t.Run("uses time.Now by default", func(t *testing.T) {
+		v := validator.New()
+		require.NoError(t, Register(v))
+		assert.NoError(t, v.Var(time.Now().Add(-time.Minute).Format(time.RFC3339), "rfc3339_before_now,rfc3339_max_age=1h"))
+		assert.Error(t, v.Var(time.Now().Add(time.Hour).Format(time.RFC3339), "rfc3339_before_now"))
+	})
+
+	t.Run("uses a clock", func(t *testing.T) {
+		clock := rfc3339.NewFakeClock(time.Date(2023, time.April, 4, 12, 0, 0, 0, time.UTC))
+		v := validator.New()
+		require.NoError(t, Register(v, WithClock(clock)))
+		assert.Error(t, v.Var("2023-04-04T13:00:00Z", "rfc3339_before_now"))
+		clock.Advance(2 * time.Hour)
+		assert.NoError(t, v.Var("2023-04-04T13:00:00Z", "rfc3339_before_now"))
+	})
+}
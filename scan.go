@@ -0,0 +1,321 @@
+package rfc3339
+
+import (
+	"io"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind identifies the RFC 3339 production of a [Token].
+type TokenKind uint8
+
+const (
+	// KindDateTime identifies a `date-time` token, parsed as a [DateTime].
+	KindDateTime TokenKind = 1 << iota
+	// KindFullDate identifies a `full-date` token, parsed as a [FullDate].
+	KindFullDate
+	// KindFullTime identifies a `full-time` token, parsed as a [FullTime].
+	KindFullTime
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case KindDateTime:
+		return "date-time"
+	case KindFullDate:
+		return "full-date"
+	case KindFullTime:
+		return "full-time"
+	default:
+		return "unknown"
+	}
+}
+
+// maxTokenLength is the length, in bytes, of the longest token that is
+// guaranteed to be found by a [Scanner] regardless of how the input is
+// split across reads. It allows for a date-time with 36 fractional second
+// digits.
+const maxTokenLength = 64
+
+// scanBufferSize is the size of the buffer a [Scanner] reads into. It limits
+// the memory used by a [Scanner], and the length of tokens it can find.
+const scanBufferSize = 32 * 1024
+
+// maxEmptyReads is the number of successive reads that return no data, and
+// no error, after which a [Scanner] gives up with [io.ErrNoProgress].
+const maxEmptyReads = 100
+
+// tokenRegex matches the productions in order of preference: a date-time is
+// reported as one token rather than as a full-date and a full-time.
+var tokenRegex = regexp.MustCompile(
+	`(\d{4}-\d{2}-\d{2}[tT]\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:[zZ]|[+-]\d{2}:\d{2}))` +
+		`|(\d{4}-\d{2}-\d{2})` +
+		`|(\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:[zZ]|[+-]\d{2}:\d{2}))`,
+)
+
+// Token is an RFC 3339 representation found in text.
+type Token struct {
+	// Kind is the production of the token.
+	Kind TokenKind
+	// Start and End are the byte offsets of the token within the text, such
+	// that `text[Start:End]` is the token.
+	Start int64
+	End   int64
+	// Text is the token as it appears in the text.
+	Text string
+	// Value is the parsed token: a [DateTime], [FullDate], or [FullTime]
+	// according to the Kind.
+	Value RFC3339
+}
+
+// FindAll returns the tokens found in the text, in order. When kinds are
+// given, only tokens of those kinds are returned. Tokens never overlap, so
+// the full-date and full-time parts of a date-time are not reported
+// separately.
+//
+// A token must not be directly preceded or followed by a letter or digit,
+// e.g. `2023-04-04T12:30:00Zulu` contains no tokens.
+func FindAll(text string, kinds ...TokenKind) []Token {
+	var tokens []Token
+	buf := []byte(text)
+	pos := 0
+	for pos < len(buf) {
+		match, ok := nextToken(buf, pos, 0)
+		if !ok {
+			break
+		}
+		pos = match.end
+		if token, ok := match.token(buf, 0, kinds); ok {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// tokenMatch is a candidate token located by [nextToken].
+type tokenMatch struct {
+	start int
+	end   int
+	kind  TokenKind
+}
+
+// nextToken finds the first match, at or after pos, that satisfies the
+// boundary rules. The offset is the position of buf within the whole input.
+// A match at the start of buf is only accepted at the start of the input,
+// since otherwise the preceding character is unknown.
+func nextToken(buf []byte, pos int, offset int64) (tokenMatch, bool) {
+	for pos < len(buf) {
+		loc := tokenRegex.FindSubmatchIndex(buf[pos:])
+		if loc == nil {
+			return tokenMatch{}, false
+		}
+
+		m := tokenMatch{start: pos + loc[0], end: pos + loc[1]}
+		switch {
+		case loc[2] >= 0:
+			m.kind = KindDateTime
+		case loc[4] >= 0:
+			m.kind = KindFullDate
+		default:
+			m.kind = KindFullTime
+		}
+
+		if (m.start > 0 || offset == 0) && isTokenBoundary(buf, m.start, m.end) {
+			return m, true
+		}
+		pos = m.start + 1
+	}
+	return tokenMatch{}, false
+}
+
+// isTokenBoundary reports whether the text around a match does not continue
+// it with a letter or digit.
+func isTokenBoundary(buf []byte, start int, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRune(buf[:start])
+		if isWordRune(r) {
+			return false
+		}
+	}
+	if end < len(buf) {
+		r, _ := utf8.DecodeRune(buf[end:])
+		if isWordRune(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// token parses the match into a [Token] if its kind is wanted.
+func (m tokenMatch) token(buf []byte, offset int64, kinds []TokenKind) (Token, bool) {
+	if len(kinds) > 0 {
+		wanted := false
+		for _, kind := range kinds {
+			wanted = wanted || kind&m.kind != 0
+		}
+		if !wanted {
+			return Token{}, false
+		}
+	}
+
+	token := Token{
+		Kind:  m.kind,
+		Start: offset + int64(m.start),
+		End:   offset + int64(m.end),
+		Text:  string(buf[m.start:m.end]),
+	}
+
+	var err error
+	switch m.kind {
+	case KindDateTime:
+		token.Value, err = NewDateTimeFromString(token.Text)
+	case KindFullDate:
+		token.Value, err = NewFullDateFromString(token.Text)
+	case KindFullTime:
+		token.Value, err = NewFullTimeFromString(token.Text)
+	}
+	if err != nil {
+		return Token{}, false
+	}
+
+	return token, true
+}
+
+// Scanner finds RFC 3339 tokens in the text read from an [io.Reader], with
+// the same rules as [FindAll]. It reads into a fixed size buffer, so memory
+// use is bounded regardless of the size of the input. Tokens of up to 64
+// bytes are always found; longer tokens may be missed.
+//
+// Successive calls to [Scanner.Scan] step through the tokens, in the manner
+// of a [bufio.Scanner]:
+//
+//	scanner := rfc3339.NewScanner(r)
+//	for scanner.Scan() {
+//		token := scanner.Token()
+//		// ...
+//	}
+//	if err := scanner.Err(); err != nil {
+//		// ...
+//	}
+type Scanner struct {
+	reader io.Reader
+	kinds  []TokenKind
+	buf    []byte
+	// offset is the position of buf[0] within the input.
+	offset int64
+	// pos is the position within buf from which to continue searching.
+	pos   int
+	eof   bool
+	err   error
+	token Token
+}
+
+// NewScanner creates a [Scanner] that reads from r. When kinds are given,
+// only tokens of those kinds are reported.
+func NewScanner(r io.Reader, kinds ...TokenKind) *Scanner {
+	return &Scanner{
+		reader: r,
+		kinds:  kinds,
+		buf:    make([]byte, 0, scanBufferSize),
+	}
+}
+
+// Scan advances to the next token, which is then available from
+// [Scanner.Token]. It returns false at the end of the input, or when an
+// error occurs; [Scanner.Err] reports the error.
+func (s *Scanner) Scan() bool {
+	for {
+		match, ok := nextToken(s.buf, s.pos, s.offset)
+
+		if !ok {
+			if s.eof || s.err != nil {
+				return false
+			}
+			// A token may begin in the final bytes of the buffer. Every
+			// candidate before those has been rejected, and is not searched
+			// again.
+			s.pos = max(s.pos, len(s.buf)-maxTokenLength)
+			s.fill(s.pos)
+			continue
+		}
+
+		// More input is needed to decide on a match near the end of the
+		// buffer: a longer production may match, or a letter or digit may
+		// follow it. The candidates before the match have been rejected.
+		if !s.eof && s.err == nil && (len(s.buf)-match.start <= maxTokenLength || match.end == len(s.buf)) {
+			s.pos = match.start
+			if len(s.buf) == cap(s.buf) && s.discardable(match.start) == 0 {
+				// No space can be made, so the token is longer than the
+				// buffer; skip it.
+				s.pos = match.start + 1
+				continue
+			}
+			s.fill(match.start)
+			continue
+		}
+
+		s.pos = match.end
+		if token, ok := match.token(s.buf, s.offset, s.kinds); ok {
+			s.token = token
+			return true
+		}
+	}
+}
+
+// Token returns the most recent token found by [Scanner.Scan].
+func (s *Scanner) Token() Token {
+	return s.token
+}
+
+// Err returns the first error, other than [io.EOF], encountered while
+// reading the input.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// discardable returns the number of bytes before the keep position that can
+// be discarded. At least [utf8.UTFMax] bytes before keep, starting with a
+// whole rune, are retained so that the rune preceding a token can be
+// decoded for boundary checks.
+func (s *Scanner) discardable(keep int) int {
+	keep -= utf8.UTFMax
+	for keep > 0 && !utf8.RuneStart(s.buf[keep]) {
+		keep -= 1
+	}
+	return max(keep, 0)
+}
+
+// fill discards the buffer before the keep position, less the bytes that
+// are retained for boundary checks, and then reads more input. The search
+// position must be at or after keep.
+func (s *Scanner) fill(keep int) {
+	if discard := s.discardable(keep); discard > 0 {
+		n := copy(s.buf, s.buf[discard:])
+		s.buf = s.buf[:n]
+		s.offset += int64(discard)
+		s.pos -= discard
+	}
+
+	for attempt := 0; len(s.buf) < cap(s.buf) && !s.eof && s.err == nil; attempt += 1 {
+		if attempt == maxEmptyReads {
+			s.err = io.ErrNoProgress
+			break
+		}
+
+		n, err := s.reader.Read(s.buf[len(s.buf):cap(s.buf)])
+		s.buf = s.buf[:len(s.buf)+n]
+		if err == io.EOF {
+			s.eof = true
+		} else if err != nil {
+			s.err = err
+		}
+		if n > 0 {
+			break
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package rfc3339
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenSummary is a comparable summary of a [Token].
+type tokenSummary struct {
+	Kind  TokenKind
+	Start int64
+	End   int64
+	Text  string
+	Value string
+}
+
+func summarize(tokens []Token) []tokenSummary {
+	summaries := make([]tokenSummary, 0, len(tokens))
+	for _, token := range tokens {
+		summaries = append(summaries, tokenSummary{
+			Kind:  token.Kind,
+			Start: token.Start,
+			End:   token.End,
+			Text:  token.Text,
+			Value: token.Value.ToString(),
+		})
+	}
+	return summaries
+}
+
+func scanAll(t *testing.T, r io.Reader, kinds ...TokenKind) []Token {
+	t.Helper()
+	var tokens []Token
+	scanner := NewScanner(r, kinds...)
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Token())
+	}
+	require.NoError(t, scanner.Err())
+	return tokens
+}
+
+func TestFindAll(t *testing.T) {
+	t.Run("finds every kind", func(t *testing.T) {
+		text := "at 2023-04-04t12:30:00.5z on 2023-04-05, by 09:00:00-04:00."
+		assert.Equal(t, []tokenSummary{
+			{Kind: KindDateTime, Start: 3, End: 25, Text: "2023-04-04t12:30:00.5z", Value: "2023-04-04T12:30:00.5Z"},
+			{Kind: KindFullDate, Start: 29, End: 39, Text: "2023-04-05", Value: "2023-04-05"},
+			{Kind: KindFullTime, Start: 44, End: 58, Text: "09:00:00-04:00", Value: "09:00:00-04:00"},
+		}, summarize(FindAll(text)))
+	})
+
+	t.Run("parses values", func(t *testing.T) {
+		tokens := FindAll("2023-04-04T12:30:00-04:00 2023-04-05 12:30:00Z")
+		require.Len(t, tokens, 3)
+		assert.Equal(t, MustParseDateTimeString("2023-04-04T12:30:00-04:00"), tokens[0].Value)
+		assert.Equal(t, MustParseDateString("2023-04-05"), tokens[1].Value)
+		assert.Equal(t, MustParseFullTimeString("12:30:00Z"), tokens[2].Value)
+	})
+
+	t.Run("spans index the text", func(t *testing.T) {
+		text := "日付: 2023-04-04、時刻: 12:30:00+09:00"
+		for _, token := range FindAll(text) {
+			assert.Equal(t, token.Text, text[token.Start:token.End])
+		}
+		assert.Len(t, FindAll(text), 2)
+	})
+
+	t.Run("filters kinds", func(t *testing.T) {
+		text := "2023-04-04T12:30:00Z 2023-04-05 13:00:00Z"
+		assert.Equal(t, []string{"2023-04-05"}, texts(FindAll(text, KindFullDate)))
+		assert.Equal(t, []string{"13:00:00Z"}, texts(FindAll(text, KindFullTime)))
+		assert.Equal(t, []string{"2023-04-04T12:30:00Z", "13:00:00Z"}, texts(FindAll(text, KindDateTime, KindFullTime)))
+		assert.Equal(t, []string{"2023-04-04T12:30:00Z", "2023-04-05"}, texts(FindAll(text, KindDateTime|KindFullDate)))
+	})
+
+	t.Run("requires boundaries", func(t *testing.T) {
+		tests := []struct {
+			text     string
+			expected []string
+		}{
+			{text: "2023-04-04T12:30:00Zulu", expected: nil},
+			{text: "x2023-04-04", expected: nil},
+			{text: "12023-04-04 2023-04-04", expected: []string{"2023-04-04"}},
+			{text: "2023-04-04T12:30:00+05:300", expected: nil},
+			{text: "112:30:00Z", expected: nil},
+			{text: "2023-04-04T12:30", expected: []string{}},
+			{text: "2023-04-04Tnoon", expected: nil},
+			{text: "é2023-04-04", expected: nil},
+			{text: "(2023-04-04)", expected: []string{"2023-04-04"}},
+			{text: "id_2023-04-04", expected: []string{"2023-04-04"}},
+			{text: "2023-04-04 12:30:00", expected: []string{"2023-04-04"}},
+		}
+		for _, test := range tests {
+			t.Run(test.text, func(t *testing.T) {
+				found := texts(FindAll(test.text))
+				if len(test.expected) == 0 {
+					assert.Empty(t, found)
+					return
+				}
+				assert.Equal(t, test.expected, found)
+			})
+		}
+	})
+
+	t.Run("finds nothing in empty text", func(t *testing.T) {
+		assert.Empty(t, FindAll(""))
+	})
+}
+
+func texts(tokens []Token) []string {
+	var found []string
+	for _, token := range tokens {
+		found = append(found, token.Text)
+	}
+	return found
+}
+
+func TestTokenKind_String(t *testing.T) {
+	assert.Equal(t, "date-time", KindDateTime.String())
+	assert.Equal(t, "full-date", KindFullDate.String())
+	assert.Equal(t, "full-time", KindFullTime.String())
+	assert.Equal(t, "unknown", TokenKind(0).String())
+}
+
+func TestScanner(t *testing.T) {
+	// The text is long enough to span several buffers, and places tokens
+	// across the buffer boundaries.
+	var b strings.Builder
+	fragments := []string{
+		"request 2023-04-04T12:30:00.123456789-04:00 ok\n",
+		"due 2023-04-05; ",
+		"window 09:00:00Z-17:00:00Z ",
+		"noise 12023-04-04 2023-04-04Tnoon x2023-04-04 ",
+		"é2023-04-06é ",
+	}
+	for i := 0; b.Len() < 3*scanBufferSize; i += 1 {
+		b.WriteString(fragments[i%len(fragments)])
+		b.WriteString(strings.Repeat(".", i%97))
+	}
+	text := b.String()
+	expected := summarize(FindAll(text))
+	require.NotEmpty(t, expected)
+
+	t.Run("matches FindAll", func(t *testing.T) {
+		assert.Equal(t, expected, summarize(scanAll(t, strings.NewReader(text))))
+	})
+
+	t.Run("matches FindAll with small reads", func(t *testing.T) {
+		assert.Equal(t, expected, summarize(scanAll(t, iotest.OneByteReader(strings.NewReader(text)))))
+		assert.Equal(t, expected, summarize(scanAll(t, iotest.HalfReader(strings.NewReader(text)))))
+		assert.Equal(t, expected, summarize(scanAll(t, iotest.DataErrReader(strings.NewReader(text)))))
+	})
+
+	t.Run("matches FindAll at every buffer split", func(t *testing.T) {
+		token := "2023-04-04T12:30:00.5+05:30"
+		for padding := scanBufferSize - len(token) - 8; padding <= scanBufferSize+8; padding += 1 {
+			input := strings.Repeat(" ", padding) + token + " 2023-04-05"
+			found := texts(scanAll(t, strings.NewReader(input)))
+			require.Equal(t, []string{token, "2023-04-05"}, found, "padding %d", padding)
+		}
+	})
+
+	t.Run("matches FindAll on multilingual text", func(t *testing.T) {
+		inputs := []string{
+			"é2023-04-04\nx0:00.123456789-04:002023-04-04T12:30:00.123456789-04:00",
+			"日付2023-04-04 時刻 12:30:00Z、2023-04-04T12:30:00Zです",
+			"😀2023-04-04😀 ж2023-04-05 (2023-04-06) ٣2023-04-07 2023-04-08٣",
+		}
+		// Repeating the inputs with varying padding places multibyte runes at
+		// every position relative to the buffer and read boundaries.
+		var long strings.Builder
+		for i := 0; long.Len() < 2*scanBufferSize; i += 1 {
+			long.WriteString(inputs[i%len(inputs)])
+			long.WriteString(strings.Repeat("é", i%61))
+		}
+		inputs = append(inputs, long.String())
+
+		for i, input := range inputs {
+			expected := summarize(FindAll(input))
+			for _, size := range []int{1, 2, 3, 5, 7, 63, 64, 65, 4096} {
+				r := &chunkReader{r: strings.NewReader(input), size: size}
+				found := summarize(scanAll(t, r))
+				require.Equal(t, expected, found, "input %d, reads of %d bytes", i, size)
+			}
+			found := summarize(scanAll(t, iotest.OneByteReader(strings.NewReader(input))))
+			require.Equal(t, expected, found, "input %d, one byte reads", i)
+		}
+	})
+
+	t.Run("filters kinds", func(t *testing.T) {
+		found := scanAll(t, strings.NewReader(text), KindFullTime)
+		assert.Equal(t, summarize(FindAll(text, KindFullTime)), summarize(found))
+	})
+
+	t.Run("skips tokens longer than the buffer", func(t *testing.T) {
+		input := "2023-04-04T12:30:00." + strings.Repeat("1", 2*scanBufferSize) + "Z then 2023-04-05"
+		assert.Equal(t, []string{"2023-04-05"}, texts(scanAll(t, strings.NewReader(input))))
+	})
+
+	t.Run("reports read errors", func(t *testing.T) {
+		r := io.MultiReader(strings.NewReader("2023-04-04 "), iotest.ErrReader(errors.New("read failed")))
+		scanner := NewScanner(r)
+		require.True(t, scanner.Scan())
+		assert.Equal(t, "2023-04-04", scanner.Token().Text)
+		assert.False(t, scanner.Scan())
+		assert.EqualError(t, scanner.Err(), "read failed")
+	})
+
+	t.Run("reports readers that make no progress", func(t *testing.T) {
+		scanner := NewScanner(emptyReader{})
+		assert.False(t, scanner.Scan())
+		assert.ErrorIs(t, scanner.Err(), io.ErrNoProgress)
+	})
+}
+
+// chunkReader returns at most size bytes from each read.
+type chunkReader struct {
+	r    io.Reader
+	size int
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if len(p) > c.size {
+		p = p[:c.size]
+	}
+	return c.r.Read(p)
+}
+
+type emptyReader struct{}
+
+func (emptyReader) Read([]byte) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,29 @@
+package rfc3339
+
+import (
+	"log/slog"
+)
+
+// ReplaceAttr is a [slog.HandlerOptions] ReplaceAttr function that rewrites
+// every [time.Time] attribute, including the record's time, into the RFC 3339
+// representation of a [DateTime]. This keeps the original UTC offset and
+// full precision, regardless of how the handler formats time values.
+func ReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	if a.Value.Kind() == slog.KindTime {
+		a.Value = NewFromTime(a.Value.Time()).LogValue()
+	}
+	return a
+}
+
+// WrapReplaceAttr combines an existing [slog.HandlerOptions] ReplaceAttr
+// function with [ReplaceAttr]. The `next` function is invoked first, and
+// any [time.Time] attribute it returns is then rewritten. A nil `next`
+// results in [ReplaceAttr].
+func WrapReplaceAttr(next func(groups []string, a slog.Attr) slog.Attr) func(groups []string, a slog.Attr) slog.Attr {
+	if next == nil {
+		return ReplaceAttr
+	}
+	return func(groups []string, a slog.Attr) slog.Attr {
+		return ReplaceAttr(groups, next(groups, a))
+	}
+}
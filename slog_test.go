@@ -0,0 +1,97 @@
+package rfc3339
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogValue(t *testing.T) {
+	t.Run("logs RFC 3339 strings", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey && len(groups) == 0 {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+
+		logger.Info(
+			"hello",
+			"created", MustParseDateTimeString("2023-04-04T12:30:00-04:00"),
+			"due", MustParseDateString("2023-04-10"),
+		)
+		assert.Equal(t, "level=INFO msg=hello created=2023-04-04T12:30:00-04:00 due=2023-04-10\n", buf.String())
+	})
+
+	t.Run("resolves values", func(t *testing.T) {
+		value := slog.AnyValue(MustParseDateTimeString("2023-04-04T12:30:00.5Z")).Resolve()
+		assert.Equal(t, slog.KindString, value.Kind())
+		assert.Equal(t, "2023-04-04T12:30:00.5Z", value.String())
+
+		value = slog.AnyValue(MustParseDateString("2023-04-04")).Resolve()
+		assert.Equal(t, "2023-04-04", value.String())
+	})
+}
+
+func TestReplaceAttr(t *testing.T) {
+	location := time.FixedZone("EDT", -4*60*60)
+	input := time.Date(2023, time.April, 4, 12, 30, 0, 123456789, location)
+
+	t.Run("rewrites time attributes", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: ReplaceAttr}))
+
+		logger.Info(
+			"hello",
+			"at", input,
+			slog.Group("request", slog.Time("received", input)),
+			"count", 3,
+		)
+
+		var found map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &found))
+		assert.Equal(t, "2023-04-04T12:30:00.123456789-04:00", found["at"])
+		assert.Equal(t, map[string]any{"received": "2023-04-04T12:30:00.123456789-04:00"}, found["request"])
+		assert.Equal(t, float64(3), found["count"])
+
+		recordTime, ok := found[slog.TimeKey].(string)
+		require.True(t, ok)
+		assert.True(t, IsDateTimeString(recordTime))
+	})
+
+	t.Run("text handler", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: ReplaceAttr}))
+		logger.Info("hello", "at", input)
+		assert.True(t, strings.HasSuffix(buf.String(), " at=2023-04-04T12:30:00.123456789-04:00\n"))
+	})
+
+	t.Run("wraps an existing function", func(t *testing.T) {
+		replace := WrapReplaceAttr(func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == "at" {
+				a.Value = slog.TimeValue(a.Value.Time().UTC())
+			}
+			return a
+		})
+
+		found := replace(nil, slog.Time("at", input))
+		assert.Equal(t, "2023-04-04T16:30:00.123456789Z", found.Value.String())
+
+		found = replace(nil, slog.String("other", "x"))
+		assert.Equal(t, "x", found.Value.String())
+	})
+
+	t.Run("wraps a nil function", func(t *testing.T) {
+		found := WrapReplaceAttr(nil)(nil, slog.Time("at", input))
+		assert.Equal(t, "2023-04-04T12:30:00.123456789-04:00", found.Value.String())
+	})
+}
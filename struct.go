@@ -0,0 +1,196 @@
+package rfc3339
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Options of the `rfc3339` struct tag recognized by [ValidateStruct] and
+// [NormalizeStruct].
+const (
+	structTagDateTime  = "datetime"
+	structTagDate      = "date"
+	structTagOmitEmpty = "omitempty"
+)
+
+// FieldError reports a string field that does not hold a valid RFC 3339
+// representation. The Path locates the field from the root struct, e.g.
+// `Events[2].Window.Start` or `Schedule[monday]`.
+type FieldError struct {
+	Path  string
+	Value string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateStruct walks the struct `v`, including nested structs, pointers,
+// slices, arrays, maps, and interfaces, and validates every string field
+// tagged `rfc3339:"datetime"` with [ValidateDateTimeString], or tagged
+// `rfc3339:"date"` with [ValidateFullDateString]. Values with out of range
+// fields, e.g. 2023-02-30, are failures, rather than being normalized into
+// another date as the parsers do. The tag also applies to the strings held
+// by a tagged slice, array, map, or pointer field. Adding the `omitempty`
+// option, e.g. `rfc3339:"date,omitempty"`, skips empty strings.
+//
+// All failures are returned together, via [errors.Join], as [*FieldError]
+// values that identify each field by its path.
+func ValidateStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	w := structWalker{visited: map[uintptr]bool{}}
+	w.walk(rv, "", structTag{})
+	return errors.Join(w.errs...)
+}
+
+// NormalizeStruct validates the struct pointed to by `v`, as
+// [ValidateStruct] does, and rewrites every valid field into the canonical
+// form of [DateTime.ToString] or [FullDate.ToString]. Leap seconds are kept
+// as second 60. Invalid fields are left as is.
+func NormalizeStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("value must be a non-nil pointer, got: %T", v)
+	}
+
+	w := structWalker{normalize: true, visited: map[uintptr]bool{}}
+	w.walk(rv, "", structTag{})
+	return errors.Join(w.errs...)
+}
+
+// structTag is the parsed form of an `rfc3339` struct tag's validation
+// options.
+type structTag struct {
+	kind      string
+	omitEmpty bool
+}
+
+// parseStructTag parses the comma separated options of an `rfc3339` struct
+// tag. Options other than `datetime`, `date`, and `omitempty` are ignored
+// so that the tag can be shared with [LoadEnv].
+func parseStructTag(tag string) structTag {
+	var parsed structTag
+	for _, option := range strings.Split(tag, ",") {
+		switch option = strings.TrimSpace(option); option {
+		case structTagDateTime, structTagDate:
+			parsed.kind = option
+		case structTagOmitEmpty:
+			parsed.omitEmpty = true
+		}
+	}
+	return parsed
+}
+
+type structWalker struct {
+	normalize bool
+	visited   map[uintptr]bool
+	errs      []error
+}
+
+// walk visits `rv`, which is located at `path`, applying `tag` to any
+// strings that are found before reaching another struct.
+func (w *structWalker) walk(rv reflect.Value, path string, tag structTag) {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() || w.visited[rv.Pointer()] {
+			return
+		}
+		w.visited[rv.Pointer()] = true
+		w.walk(rv.Elem(), path, tag)
+
+	case reflect.Interface:
+		if rv.IsNil() {
+			return
+		}
+		// The value held by an interface cannot be modified in place, so a
+		// copy is walked and then stored back.
+		elem := reflect.New(rv.Elem().Type()).Elem()
+		elem.Set(rv.Elem())
+		w.walk(elem, path, tag)
+		if w.normalize && rv.CanSet() {
+			rv.Set(elem)
+		}
+
+	case reflect.Struct:
+		typ := rv.Type()
+		for i := 0; i < typ.NumField(); i += 1 {
+			sf := typ.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			fieldPath := sf.Name
+			if path != "" {
+				fieldPath = path + "." + sf.Name
+			}
+			w.walk(rv.Field(i), fieldPath, parseStructTag(sf.Tag.Get("rfc3339")))
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i += 1 {
+			w.walk(rv.Index(i), fmt.Sprintf("%s[%d]", path, i), tag)
+		}
+
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			// Map values are not addressable, so a copy is walked and then
+			// stored back.
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+			w.walk(elem, fmt.Sprintf("%s[%v]", path, iter.Key()), tag)
+			if w.normalize {
+				rv.SetMapIndex(iter.Key(), elem)
+			}
+		}
+
+	case reflect.String:
+		w.check(rv, path, tag)
+	}
+}
+
+// check validates, and optionally normalizes, a string value.
+func (w *structWalker) check(rv reflect.Value, path string, tag structTag) {
+	value := rv.String()
+	if tag.kind == "" || (tag.omitEmpty && value == "") {
+		return
+	}
+
+	var err error
+	var canonical string
+	switch tag.kind {
+	case structTagDateTime:
+		if err = ValidateDateTimeString(value); err == nil {
+			canonical = canonicalDateTime(value)
+		}
+	case structTagDate:
+		if err = ValidateFullDateString(value); err == nil {
+			canonical = MustParseDateString(value).ToString()
+		}
+	}
+	if err != nil {
+		w.errs = append(w.errs, &FieldError{Path: path, Value: value, Err: err})
+		return
+	}
+
+	if w.normalize && rv.CanSet() {
+		rv.SetString(canonical)
+	}
+}
+
+// canonicalDateTime writes a valid `date-time` string in the form of
+// [DateTime.ToString]. A leap second cannot be held by a [DateTime], so the
+// preceding second is written instead, and then its seconds replaced by 60.
+func canonicalDateTime(value string) string {
+	if value[17:19] != "60" {
+		return MustParseDateTimeString(value).ToString()
+	}
+	canonical := MustParseDateTimeString(value[:17] + "59" + value[19:]).ToString()
+	return canonical[:17] + "60" + canonical[19:]
+}
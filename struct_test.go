@@ -0,0 +1,208 @@
+package rfc3339
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type legacyWindow struct {
+	Start string `rfc3339:"datetime"`
+	End   string `rfc3339:"datetime,omitempty"`
+}
+
+type legacyTimestamp string
+
+type legacyEvent struct {
+	Name     string
+	Day      string          `rfc3339:"date"`
+	Created  legacyTimestamp `rfc3339:"datetime"`
+	Window   legacyWindow
+	Windows  []legacyWindow
+	Pointer  *legacyWindow
+	Seen     []string          `rfc3339:"datetime"`
+	Holidays [2]string         `rfc3339:"date,omitempty"`
+	Schedule map[string]string `rfc3339:"datetime"`
+	Nested   map[string]legacyWindow
+	Optional *string `rfc3339:"date"`
+	Any      any
+	Shared   string   `rfc3339:"env=SHARED,date"`
+	Since    DateTime `rfc3339:"env=SINCE"`
+	internal string   `rfc3339:"date"`
+}
+
+func validLegacyEvent() *legacyEvent {
+	optional := "2023-04-05"
+	return &legacyEvent{
+		Name:     "not a date",
+		Day:      "2023-04-04",
+		Created:  "2023-04-04T12:30:00-04:00",
+		Window:   legacyWindow{Start: "2023-04-04T12:30:00Z"},
+		Windows:  []legacyWindow{{Start: "2023-04-04T12:30:00Z", End: "2023-04-04T13:30:00Z"}},
+		Pointer:  &legacyWindow{Start: "2023-04-04T12:30:00Z"},
+		Seen:     []string{"2023-04-04T12:30:00Z"},
+		Holidays: [2]string{"2023-12-25"},
+		Schedule: map[string]string{"monday": "2023-04-03T09:00:00Z"},
+		Nested:   map[string]legacyWindow{"a": {Start: "2023-04-04T12:30:00Z"}},
+		Optional: &optional,
+		Any:      &legacyWindow{Start: "2023-04-04T12:30:00Z"},
+		Shared:   "2023-04-04",
+		internal: "invalid",
+	}
+}
+
+func fieldErrorPaths(t *testing.T, err error) map[string]string {
+	t.Helper()
+	found := map[string]string{}
+	if err == nil {
+		return found
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	require.True(t, ok)
+	for _, e := range joined.Unwrap() {
+		var fieldErr *FieldError
+		require.True(t, errors.As(e, &fieldErr))
+		found[fieldErr.Path] = fieldErr.Value
+	}
+	return found
+}
+
+func TestValidateStruct(t *testing.T) {
+	t.Run("accepts valid values", func(t *testing.T) {
+		input := validLegacyEvent()
+		assert.NoError(t, ValidateStruct(input))
+		assert.NoError(t, ValidateStruct(*input))
+	})
+
+	t.Run("reports every failure with its path", func(t *testing.T) {
+		input := validLegacyEvent()
+		input.Day = "04/04/2023"
+		input.Created = "2023-04-04 12:30:00Z"
+		input.Window.Start = ""
+		input.Windows = append(input.Windows, legacyWindow{Start: "2023-04-04T12:30:00Z", End: "2023-04-04"})
+		input.Pointer.Start = "now"
+		input.Seen = append(input.Seen, "2023-04-04T12:30:00")
+		input.Holidays[1] = "2023-12-26T00:00:00Z"
+		input.Schedule["tuesday"] = "09:00"
+		input.Nested["b"] = legacyWindow{Start: "2023-04-04"}
+		*input.Optional = "tomorrow"
+		input.Any = legacyWindow{Start: "x"}
+		input.Shared = "2023-04-04T00:00:00Z"
+
+		err := ValidateStruct(input)
+		assert.Equal(t, map[string]string{
+			"Day":               "04/04/2023",
+			"Created":           "2023-04-04 12:30:00Z",
+			"Window.Start":      "",
+			"Windows[1].End":    "2023-04-04",
+			"Pointer.Start":     "now",
+			"Seen[1]":           "2023-04-04T12:30:00",
+			"Holidays[1]":       "2023-12-26T00:00:00Z",
+			"Schedule[tuesday]": "09:00",
+			"Nested[b].Start":   "2023-04-04",
+			"Optional":          "tomorrow",
+			"Any.Start":         "x",
+			"Shared":            "2023-04-04T00:00:00Z",
+		}, fieldErrorPaths(t, err))
+		assert.ErrorContains(t, err, "Day: `04/04/2023` is not a full-date string")
+		assert.ErrorContains(t, err, "Pointer.Start: input is not a date-time string: now")
+	})
+
+	t.Run("reports out of range fields", func(t *testing.T) {
+		input := validLegacyEvent()
+		input.Day = "2023-02-30"
+		input.Created = "2023-04-04T24:30:00-04:00"
+		input.Window.Start = "2023-04-04T12:30:00+99:99"
+		input.Windows[0].Start = "2023-01-01T00:00:00+24:00"
+		input.Seen = []string{"2023-04-04T12:30:00-00:00", "2016-12-31T12:59:60Z"}
+
+		err := ValidateStruct(input)
+		assert.Equal(t, map[string]string{
+			"Day":              "2023-02-30",
+			"Created":          "2023-04-04T24:30:00-04:00",
+			"Window.Start":     "2023-04-04T12:30:00+99:99",
+			"Windows[0].Start": "2023-01-01T00:00:00+24:00",
+			"Seen[1]":          "2016-12-31T12:59:60Z",
+		}, fieldErrorPaths(t, err))
+		assert.ErrorContains(t, err, "Day: `2023-02-30` is not a valid full-date: day out of range")
+		assert.ErrorContains(t, err, "Windows[0].Start: `2023-01-01T00:00:00+24:00` is not a valid date-time: offset hour out of range")
+	})
+
+	t.Run("accepts leap seconds at 23:59 UTC", func(t *testing.T) {
+		// The examples of RFC 3339 section 5.8.
+		input := validLegacyEvent()
+		input.Seen = []string{"1990-12-31T23:59:60Z", "1990-12-31T15:59:60-08:00"}
+		assert.NoError(t, ValidateStruct(input))
+	})
+
+	t.Run("handles cycles", func(t *testing.T) {
+		type node struct {
+			At   string `rfc3339:"datetime"`
+			Next *node
+		}
+		first := &node{At: "bad"}
+		first.Next = &node{At: "2023-04-04T12:30:00Z", Next: first}
+
+		err := ValidateStruct(first)
+		assert.Equal(t, map[string]string{"At": "bad"}, fieldErrorPaths(t, err))
+	})
+
+	t.Run("ignores nil values", func(t *testing.T) {
+		assert.NoError(t, ValidateStruct(nil))
+		assert.NoError(t, ValidateStruct(&legacyEvent{Day: "2023-04-04", Created: "2023-04-04T12:30:00Z", Window: legacyWindow{Start: "2023-04-04T12:30:00Z"}, Shared: "2023-04-04"}))
+	})
+}
+
+func TestNormalizeStruct(t *testing.T) {
+	t.Run("rewrites valid values", func(t *testing.T) {
+		input := validLegacyEvent()
+		input.Created = "2023-04-04t12:30:00.500-04:00"
+		input.Windows[0].End = "2023-04-04T13:30:00.000000z"
+		input.Seen = []string{"2023-04-04t12:30:00Z", "invalid"}
+		input.Schedule["monday"] = "2023-04-03T09:00:00.10+00:00"
+		input.Nested["a"] = legacyWindow{Start: "2023-04-04t12:30:00z"}
+		input.Any = legacyWindow{Start: "2023-04-04t12:30:00z"}
+
+		err := NormalizeStruct(input)
+		assert.Equal(t, map[string]string{"Seen[1]": "invalid"}, fieldErrorPaths(t, err))
+
+		assert.Equal(t, legacyTimestamp("2023-04-04T12:30:00.5-04:00"), input.Created)
+		assert.Equal(t, "2023-04-04T13:30:00Z", input.Windows[0].End)
+		assert.Equal(t, []string{"2023-04-04T12:30:00Z", "invalid"}, input.Seen)
+		assert.Equal(t, "2023-04-03T09:00:00.1Z", input.Schedule["monday"])
+		assert.Equal(t, "2023-04-04T12:30:00Z", input.Nested["a"].Start)
+		assert.Equal(t, legacyWindow{Start: "2023-04-04T12:30:00Z"}, input.Any)
+		assert.Equal(t, "not a date", input.Name)
+	})
+
+	t.Run("keeps leap seconds", func(t *testing.T) {
+		input := validLegacyEvent()
+		input.Seen = []string{"1990-12-31t23:59:60.50z", "1990-12-31T15:59:60-08:00"}
+
+		err := NormalizeStruct(input)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1990-12-31T23:59:60.5Z", "1990-12-31T15:59:60-08:00"}, input.Seen)
+	})
+
+	t.Run("leaves out of range values as is", func(t *testing.T) {
+		input := validLegacyEvent()
+		input.Day = "2023-02-30"
+		input.Created = "2023-04-04t24:30:00-04:00"
+
+		err := NormalizeStruct(input)
+		assert.Equal(t, map[string]string{
+			"Day":     "2023-02-30",
+			"Created": "2023-04-04t24:30:00-04:00",
+		}, fieldErrorPaths(t, err))
+		assert.Equal(t, "2023-02-30", input.Day)
+		assert.Equal(t, legacyTimestamp("2023-04-04t24:30:00-04:00"), input.Created)
+	})
+
+	t.Run("requires a pointer", func(t *testing.T) {
+		err := NormalizeStruct(legacyEvent{})
+		assert.ErrorContains(t, err, "value must be a non-nil pointer, got: rfc3339.legacyEvent")
+	})
+}
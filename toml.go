@@ -0,0 +1,26 @@
+package rfc3339
+
+import "time"
+
+// Decoders that supply decoded TOML values, e.g. github.com/BurntSushi/toml,
+// represent the TOML local date-time, local date, and local time types as
+// [time.Time] instances in fixed zones with these names.
+const (
+	tomlLocalDateTime = "datetime-local"
+	tomlLocalDate     = "date-local"
+	tomlLocalTime     = "time-local"
+)
+
+// tomlKind names the TOML type that was decoded into the value.
+func tomlKind(value time.Time) string {
+	switch value.Location().String() {
+	case tomlLocalDateTime:
+		return "local date-time"
+	case tomlLocalDate:
+		return "local date"
+	case tomlLocalTime:
+		return "local time"
+	default:
+		return "offset date-time"
+	}
+}
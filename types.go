@@ -18,3 +18,27 @@ type DateTime struct {
 type FullDate struct {
 	time.Time
 }
+
+// PartialTime represents an RFC 3339 `partial-time`, i.e. a time of day
+// without a UTC offset. It is a wrapper for [time.Time]. PartialTime objects
+// set the date parts to January 1, year 0, at the UTC (+00:00) offset; the
+// offset does not carry any meaning.
+type PartialTime struct {
+	time.Time
+}
+
+// LocalDateTime represents a date and time of day without a UTC offset,
+// i.e. an RFC 3339 `full-date "T" partial-time`, such as a TOML local
+// date-time. It is a wrapper for [time.Time]. LocalDateTime objects are at
+// the UTC (+00:00) offset; the offset does not carry any meaning. Use
+// [LocalDateTime.At] to place the value in a location.
+type LocalDateTime struct {
+	time.Time
+}
+
+// FullTime represents an RFC 3339 `full-time`, i.e. a time of day with a UTC
+// offset. It is a wrapper for [time.Time]. FullTime objects set the date
+// parts to January 1, year 0, in the location of the offset.
+type FullTime struct {
+	time.Time
+}
@@ -28,7 +28,8 @@ func nsToInt(input string) int {
 }
 
 // toInt converts a string to an integer. Leading `0` characters will be
-// trimmed before converting.
+// trimmed before converting, so that the input is not interpreted as an
+// octal number.
 func toInt(input string) int {
-	return cast.ToInt(strings.TrimPrefix(input, "0"))
+	return cast.ToInt(strings.TrimLeft(input, "0"))
 }
@@ -19,6 +19,8 @@ func TestNsToInt(t *testing.T) {
 			{input: ".5", expected: 500000000},
 			{input: ".000000005", expected: 5},
 			{input: ".1234567891", expected: 123456789},
+			{input: ".0051", expected: 5100000},
+			{input: ".00089", expected: 890000},
 			{input: "005", expected: 5000000}, // no leading `.`
 		}
 
@@ -47,6 +49,9 @@ func TestToInt(t *testing.T) {
 	tests := [][]interface{}{
 		{"08", 8},
 		{"0005", 5},
+		{"0051", 51},
+		{"0089", 89},
+		{"0000", 0},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,112 @@
+package rfc3339
+
+import (
+	"fmt"
+	"time"
+)
+
+// ValidateDateTimeString verifies the input is an RFC 3339 `date-time`
+// string whose fields are within the ranges of RFC 3339 section 5.7: the day
+// must exist in the month, and a leap second, second 60, is only in range at
+// 23:59 UTC. [NewDateTimeFromString] is more lenient, and normalizes out of
+// range fields into another instant.
+func ValidateDateTimeString(input string) error {
+	if !IsDateTimeString(input) {
+		return fmt.Errorf("input is not a date-time string: %s", input)
+	}
+	if field := dateOutOfRange(input[:10]); field != "" {
+		return fmt.Errorf("`%s` is not a valid date-time: %s out of range", input, field)
+	}
+	if field := timeOutOfRange(input[11:]); field != "" {
+		return fmt.Errorf("`%s` is not a valid date-time: %s out of range", input, field)
+	}
+	return nil
+}
+
+// ValidateFullDateString verifies the input is an RFC 3339 `full-date`
+// string whose fields are within the ranges of RFC 3339 section 5.7.
+// [NewFullDateFromString] is more lenient, and normalizes out of range
+// fields into another date.
+func ValidateFullDateString(input string) error {
+	if !IsFullDateString(input) {
+		return fmt.Errorf("`%s` is not a full-date string", input)
+	}
+	if field := dateOutOfRange(input); field != "" {
+		return fmt.Errorf("`%s` is not a valid full-date: %s out of range", input, field)
+	}
+	return nil
+}
+
+// ValidateFullTimeString verifies the input is an RFC 3339 `full-time`
+// string whose fields are within the ranges of RFC 3339 section 5.7.
+func ValidateFullTimeString(input string) error {
+	if !IsFullTimeString(input) {
+		return fmt.Errorf("`%s` is not a full-time string", input)
+	}
+	if field := timeOutOfRange(input); field != "" {
+		return fmt.Errorf("`%s` is not a valid full-time: %s out of range", input, field)
+	}
+	return nil
+}
+
+// dateOutOfRange names the first field of a `full-date`, in the form
+// YYYY-MM-DD, that is out of range, or returns an empty string. The day must
+// exist in the month, taking leap years into account.
+func dateOutOfRange(date string) string {
+	year := toInt(date[0:4])
+	month := toInt(date[5:7])
+	day := toInt(date[8:10])
+
+	if month < 1 || month > 12 {
+		return "month"
+	}
+	lastDay := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day < 1 || day > lastDay {
+		return "day"
+	}
+	return ""
+}
+
+// timeOutOfRange names the first field of a `full-time`, in the form
+// hh:mm:ss[.frac](Z|+hh:mm|-hh:mm), that is out of range, or returns an
+// empty string. A leap second, second 60, is only in range at 23:59 UTC.
+func timeOutOfRange(fullTime string) string {
+	hour := toInt(fullTime[0:2])
+	minute := toInt(fullTime[3:5])
+	second := toInt(fullTime[6:8])
+
+	if hour > 23 {
+		return "hour"
+	}
+	if minute > 59 {
+		return "minute"
+	}
+
+	offset := 0
+	if zone := fullTime[len(fullTime)-1]; zone != 'Z' && zone != 'z' {
+		numOffset := fullTime[len(fullTime)-6:]
+		offsetHour := toInt(numOffset[1:3])
+		offsetMinute := toInt(numOffset[4:6])
+		if offsetHour > 23 {
+			return "offset hour"
+		}
+		if offsetMinute > 59 {
+			return "offset minute"
+		}
+		offset = offsetHour*60 + offsetMinute
+		if numOffset[0] == '-' {
+			offset = -offset
+		}
+	}
+
+	if second > 60 {
+		return "second"
+	}
+	if second == 60 {
+		utc := ((hour*60+minute-offset)%(24*60) + 24*60) % (24 * 60)
+		if utc != 23*60+59 {
+			return "second"
+		}
+	}
+	return ""
+}
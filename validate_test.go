@@ -0,0 +1,89 @@
+package rfc3339
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDateTimeString(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: "2023-04-04T12:30:00-04:00"},
+		{input: "2023-04-04t12:30:00.5z"},
+		{input: "2024-02-29T00:00:00Z"},
+		{input: "1990-12-31T23:59:60Z"},
+		{input: "1990-12-31T15:59:60-08:00"},
+		{input: "1991-01-01T05:29:60+05:30"},
+		{input: "2023-04-04", err: "input is not a date-time string: 2023-04-04"},
+		{input: "2023-02-29T12:30:00Z", err: "`2023-02-29T12:30:00Z` is not a valid date-time: day out of range"},
+		{input: "2023-00-01T12:30:00Z", err: "`2023-00-01T12:30:00Z` is not a valid date-time: month out of range"},
+		{input: "2023-04-04T24:00:00Z", err: "`2023-04-04T24:00:00Z` is not a valid date-time: hour out of range"},
+		{input: "2023-04-04T12:60:00Z", err: "`2023-04-04T12:60:00Z` is not a valid date-time: minute out of range"},
+		{input: "2023-01-01T00:00:00+24:00", err: "`2023-01-01T00:00:00+24:00` is not a valid date-time: offset hour out of range"},
+		{input: "2023-01-01T00:00:00-05:60", err: "`2023-01-01T00:00:00-05:60` is not a valid date-time: offset minute out of range"},
+		{input: "1990-12-31T23:59:60-08:00", err: "`1990-12-31T23:59:60-08:00` is not a valid date-time: second out of range"},
+		{input: "1990-12-31T23:59:61Z", err: "`1990-12-31T23:59:61Z` is not a valid date-time: second out of range"},
+	}
+
+	for _, test := range tests {
+		err := ValidateDateTimeString(test.input)
+		if test.err == "" {
+			assert.NoError(t, err, test.input)
+		} else {
+			assert.EqualError(t, err, test.err)
+		}
+	}
+}
+
+func TestValidateFullDateString(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: "2023-04-04"},
+		{input: "2000-02-29"},
+		{input: "0000-01-01"},
+		{input: "2023-4-4", err: "`2023-4-4` is not a full-date string"},
+		{input: "1900-02-29", err: "`1900-02-29` is not a valid full-date: day out of range"},
+		{input: "2023-04-31", err: "`2023-04-31` is not a valid full-date: day out of range"},
+		{input: "2023-04-00", err: "`2023-04-00` is not a valid full-date: day out of range"},
+		{input: "2023-13-01", err: "`2023-13-01` is not a valid full-date: month out of range"},
+	}
+
+	for _, test := range tests {
+		err := ValidateFullDateString(test.input)
+		if test.err == "" {
+			assert.NoError(t, err, test.input)
+		} else {
+			assert.EqualError(t, err, test.err)
+		}
+	}
+}
+
+func TestValidateFullTimeString(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{input: "12:30:00Z"},
+		{input: "12:30:00.123+05:30"},
+		{input: "23:59:60Z"},
+		{input: "00:29:60+00:30"},
+		{input: "12:30:00", err: "`12:30:00` is not a full-time string"},
+		{input: "24:00:00Z", err: "`24:00:00Z` is not a valid full-time: hour out of range"},
+		{input: "12:30:60Z", err: "`12:30:60Z` is not a valid full-time: second out of range"},
+		{input: "12:30:00+24:00", err: "`12:30:00+24:00` is not a valid full-time: offset hour out of range"},
+	}
+
+	for _, test := range tests {
+		err := ValidateFullTimeString(test.input)
+		if test.err == "" {
+			assert.NoError(t, err, test.input)
+		} else {
+			assert.EqualError(t, err, test.err)
+		}
+	}
+}
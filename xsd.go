@@ -0,0 +1,62 @@
+package rfc3339
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// xsEndOfDayRegex matches an XML Schema `xs:dateTime` that uses the
+// `24:00:00` representation of the end of a day.
+var xsEndOfDayRegex = regexp.MustCompile(
+	`^(\d{4}-\d{2}-\d{2}[tT])24:00:00(?:\.0+)?([zZ]|[+-]\d{2}:\d{2})$`,
+)
+
+// xsHour24Regex matches any `xs:dateTime` at hour 24. Only the exact end of
+// day, matched by xsEndOfDayRegex, is valid.
+var xsHour24Regex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}[tT]24:`)
+
+// xsDateRegex matches an XML Schema `xs:date`, which may include an
+// optional timezone.
+var xsDateRegex = regexp.MustCompile(
+	`^(\d{4}-\d{2}-\d{2})(?:[zZ]|[+-]\d{2}:\d{2})?$`,
+)
+
+// parseXSDateTime creates a [DateTime] from an XML Schema `xs:dateTime`
+// lexical representation. Beyond the RFC 3339 `date-time` production, it
+// accepts surrounding whitespace and the `24:00:00` end of day time, which
+// is mapped to midnight of the following day. Representations without a
+// timezone are rejected, because a [DateTime] requires an offset.
+func parseXSDateTime(input string) (DateTime, error) {
+	input = strings.TrimSpace(input)
+
+	matches := xsEndOfDayRegex.FindStringSubmatch(input)
+	if matches == nil {
+		if xsHour24Regex.MatchString(input) {
+			return DateTime{}, fmt.Errorf("input is not a date-time string: %s", input)
+		}
+		return NewDateTimeFromString(input)
+	}
+
+	dt, err := NewDateTimeFromString(matches[1] + "00:00:00" + matches[2])
+	if err != nil {
+		return DateTime{}, fmt.Errorf("input is not a date-time string: %s", input)
+	}
+
+	return DateTime{Time: dt.AddDate(0, 0, 1)}, nil
+}
+
+// parseXSDate creates a [FullDate] from an XML Schema `xs:date` lexical
+// representation. Beyond the RFC 3339 `full-date` production, it accepts
+// surrounding whitespace and an optional timezone. The timezone is discarded,
+// as [FullDate] instances are always at the UTC (+00:00) offset.
+func parseXSDate(input string) (FullDate, error) {
+	input = strings.TrimSpace(input)
+
+	matches := xsDateRegex.FindStringSubmatch(input)
+	if matches == nil {
+		return FullDate{}, fmt.Errorf("`%s` is not a full-date string", input)
+	}
+
+	return NewFullDateFromString(matches[1])
+}
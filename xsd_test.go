@@ -0,0 +1,75 @@
+package rfc3339
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseXSDateTime(t *testing.T) {
+	t.Run("parses date-time strings", func(t *testing.T) {
+		dt, err := parseXSDateTime(" 2023-04-01T08:30:00.5-04:00\n")
+		require.NoError(t, err)
+		assert.Equal(t, "2023-04-01T08:30:00.5-04:00", dt.ToString())
+	})
+
+	t.Run("maps end of day to the following day", func(t *testing.T) {
+		tests := [][]string{
+			{"2023-04-01T24:00:00Z", "2023-04-02T00:00:00Z"},
+			{"2023-12-31T24:00:00.000-04:00", "2024-01-01T00:00:00-04:00"},
+			{"2023-04-04t24:00:00z", "2023-04-05T00:00:00Z"},
+		}
+
+		for _, test := range tests {
+			dt, err := parseXSDateTime(test[0])
+			require.NoError(t, err)
+			assert.Equal(t, test[1], dt.ToString())
+		}
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		inputs := []string{
+			"2023-04-01T08:30:00",
+			"2023-04-01T24:00:00",
+			"2023-04-01T24:00:00.5Z",
+			"2023-04-01T24:00:01Z",
+			"2023-04-01t24:00:01Z",
+			"2023-04-01T24:30:00-04:00",
+			"2023-04-01T24:00:00Zulu",
+			"-2023-04-01T08:30:00Z",
+		}
+
+		for _, input := range inputs {
+			_, err := parseXSDateTime(input)
+			assert.EqualError(t, err, "input is not a date-time string: "+input)
+		}
+	})
+}
+
+func TestParseXSDate(t *testing.T) {
+	t.Run("parses date strings with optional timezones", func(t *testing.T) {
+		inputs := []string{
+			"2023-04-01",
+			"2023-04-01Z",
+			"2023-04-01-05:00",
+			"2023-04-01+14:00",
+			"\t2023-04-01 ",
+		}
+
+		for _, input := range inputs {
+			fd, err := parseXSDate(input)
+			require.NoError(t, err)
+			assert.Equal(t, MustParseDateString("2023-04-01"), fd)
+		}
+	})
+
+	t.Run("returns error for bad input", func(t *testing.T) {
+		inputs := []string{"2023-04", "2023-04-01T00:00:00Z", "2023-04-01+5"}
+
+		for _, input := range inputs {
+			_, err := parseXSDate(input)
+			assert.EqualError(t, err, "`"+input+"` is not a full-date string")
+		}
+	})
+}